@@ -164,6 +164,11 @@ func (s *ConditionedStatus) GetCondition(ct ConditionType) Condition {
 	return Condition{Type: ct, Status: corev1.ConditionUnknown}
 }
 
+// GetConditions returns all of the conditions.
+func (s *ConditionedStatus) GetConditions() []Condition {
+	return s.Conditions
+}
+
 // SetConditions sets the supplied conditions, replacing any existing conditions
 // of the same type. This is a no-op if all supplied conditions are identical,
 // ignoring the last transition time, to those already set.
@@ -193,6 +198,25 @@ func (s *ConditionedStatus) SetConditions(c ...Condition) {
 	}
 }
 
+// RemoveConditions removes any existing conditions of the supplied types. This
+// is a no-op for types that have no existing condition.
+func (s *ConditionedStatus) RemoveConditions(t ...ConditionType) {
+	if len(s.Conditions) == 0 {
+		return
+	}
+	remove := make(map[ConditionType]bool, len(t))
+	for _, ct := range t {
+		remove[ct] = true
+	}
+	kept := make([]Condition, 0, len(s.Conditions))
+	for _, c := range s.Conditions {
+		if !remove[c.Type] {
+			kept = append(kept, c)
+		}
+	}
+	s.Conditions = kept
+}
+
 // Equal returns true if the status is identical to the supplied status,
 // ignoring the LastTransitionTimes and order of statuses.
 func (s *ConditionedStatus) Equal(other *ConditionedStatus) bool {
@@ -163,6 +163,41 @@ func TestSetConditions(t *testing.T) {
 	}
 }
 
+func TestRemoveConditions(t *testing.T) {
+	cases := map[string]struct {
+		cs   *ConditionedStatus
+		t    []ConditionType
+		want *ConditionedStatus
+	}{
+		"RemoveOneOfSeveral": {
+			cs:   NewConditionedStatus(Available(), ReconcileSuccess(), Condition{Type: "LastBackup", Status: corev1.ConditionTrue}),
+			t:    []ConditionType{"LastBackup"},
+			want: NewConditionedStatus(Available(), ReconcileSuccess()),
+		},
+		"RemoveMissing": {
+			cs:   NewConditionedStatus(Available(), ReconcileSuccess()),
+			t:    []ConditionType{"LastBackup"},
+			want: NewConditionedStatus(Available(), ReconcileSuccess()),
+		},
+		"RemoveFromEmpty": {
+			cs:   NewConditionedStatus(),
+			t:    []ConditionType{TypeReady},
+			want: NewConditionedStatus(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.cs.RemoveConditions(tc.t...)
+
+			got := tc.cs
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("tc.cs.RemoveConditions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetCondition(t *testing.T) {
 	cases := map[string]struct {
 		cs   *ConditionedStatus
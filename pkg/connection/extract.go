@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+)
+
+// Error strings.
+const (
+	errPaveObject = "cannot convert object to unstructured data"
+
+	errFmtInvalidDetailSpec    = "connection detail %q must specify exactly one of fromFieldPath, fromConnectionSecretKey, or value"
+	errFmtGetFieldPath         = "cannot get connection detail %q from field path %q"
+	errFmtMarshalFieldPath     = "cannot marshal value of field path %q for connection detail %q"
+	errFmtMissingConnectionKey = "cannot get connection detail %q from missing connection secret key %q"
+)
+
+// A ConnectionDetailSpec specifies how to extract a connection detail from an
+// observed object. Exactly one of FromFieldPath, FromConnectionSecretKey, and
+// Value must be set.
+type ConnectionDetailSpec struct {
+	// Key of the extracted connection detail.
+	Key string
+
+	// FromFieldPath extracts the value at the supplied field path of the
+	// observed object. String values are extracted as is, while other values
+	// are extracted as JSON.
+	FromFieldPath *string
+
+	// FromConnectionSecretKey extracts the value of the supplied key of the
+	// observed object's connection details.
+	FromConnectionSecretKey *string
+
+	// Value is extracted as is.
+	Value *string
+}
+
+// A ConnectionDetailExtractorOption configures a ConnectionDetailExtractor.
+type ConnectionDetailExtractorOption func(*ConnectionDetailExtractor)
+
+// WithMissingSourcesSkipped configures a ConnectionDetailExtractor to skip
+// connection details whose field path or connection secret key doesn't exist.
+// By default missing sources cause extraction to fail.
+func WithMissingSourcesSkipped() ConnectionDetailExtractorOption {
+	return func(e *ConnectionDetailExtractor) {
+		e.skipMissing = true
+	}
+}
+
+// A ConnectionDetailExtractor extracts connection details from an observed
+// object per a list of ConnectionDetailSpecs.
+type ConnectionDetailExtractor struct {
+	specs       []ConnectionDetailSpec
+	skipMissing bool
+}
+
+// NewConnectionDetailExtractor returns a ConnectionDetailExtractor that
+// extracts the supplied connection details.
+func NewConnectionDetailExtractor(specs []ConnectionDetailSpec, o ...ConnectionDetailExtractorOption) *ConnectionDetailExtractor {
+	e := &ConnectionDetailExtractor{specs: specs}
+	for _, fn := range o {
+		fn(e)
+	}
+	return e
+}
+
+// Extract connection details from the supplied object and its existing
+// connection details, if any.
+func (e *ConnectionDetailExtractor) Extract(o runtime.Object, conn managed.ConnectionDetails) (managed.ConnectionDetails, error) {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return nil, errors.Wrap(err, errPaveObject)
+	}
+
+	out := managed.ConnectionDetails{}
+	for _, s := range e.specs {
+		switch {
+		case s.FromFieldPath != nil && s.FromConnectionSecretKey == nil && s.Value == nil:
+			v, err := p.GetValue(*s.FromFieldPath)
+			if fieldpath.IsNotFound(err) && e.skipMissing {
+				continue
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, errFmtGetFieldPath, s.Key, *s.FromFieldPath)
+			}
+			if str, ok := v.(string); ok {
+				out[s.Key] = []byte(str)
+				continue
+			}
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, errFmtMarshalFieldPath, *s.FromFieldPath, s.Key)
+			}
+			out[s.Key] = b
+		case s.FromConnectionSecretKey != nil && s.FromFieldPath == nil && s.Value == nil:
+			v, ok := conn[*s.FromConnectionSecretKey]
+			if !ok && e.skipMissing {
+				continue
+			}
+			if !ok {
+				return nil, errors.Errorf(errFmtMissingConnectionKey, s.Key, *s.FromConnectionSecretKey)
+			}
+			out[s.Key] = v
+		case s.Value != nil && s.FromFieldPath == nil && s.FromConnectionSecretKey == nil:
+			out[s.Key] = []byte(*s.Value)
+		default:
+			return nil, errors.Errorf(errFmtInvalidDetailSpec, s.Key)
+		}
+	}
+	return out, nil
+}
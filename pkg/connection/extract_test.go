@@ -0,0 +1,171 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestConnectionDetailExtractorExtract(t *testing.T) {
+	observed := &kunstructured.Unstructured{Object: map[string]any{
+		"status": map[string]any{
+			"atProvider": map[string]any{
+				"endpoint": "db.example.org",
+				"port":     int64(5432),
+			},
+		},
+	}}
+	conn := managed.ConnectionDetails{"password": []byte("secret")}
+
+	type args struct {
+		specs []ConnectionDetailSpec
+		o     []ConnectionDetailExtractorOption
+		obj   runtime.Object
+		conn  managed.ConnectionDetails
+	}
+	type want struct {
+		cd  managed.ConnectionDetails
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"FromFieldPathString": {
+			reason: "A string field path value should be extracted as is.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "endpoint", FromFieldPath: ptr.To("status.atProvider.endpoint")}},
+				obj:   observed,
+			},
+			want: want{
+				cd: managed.ConnectionDetails{"endpoint": []byte("db.example.org")},
+			},
+		},
+		"FromFieldPathNonString": {
+			reason: "A non-string field path value should be extracted as JSON.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "port", FromFieldPath: ptr.To("status.atProvider.port")}},
+				obj:   observed,
+			},
+			want: want{
+				cd: managed.ConnectionDetails{"port": []byte("5432")},
+			},
+		},
+		"FromFieldPathMissing": {
+			reason: "A missing field path should return an error by default.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "user", FromFieldPath: ptr.To("status.atProvider.user")}},
+				obj:   observed,
+			},
+			want: want{
+				err: errors.Wrapf(errors.New("status.atProvider.user: no such field"), errFmtGetFieldPath, "user", "status.atProvider.user"),
+			},
+		},
+		"FromFieldPathMissingSkipped": {
+			reason: "A missing field path should be skipped if configured.",
+			args: args{
+				specs: []ConnectionDetailSpec{
+					{Key: "user", FromFieldPath: ptr.To("status.atProvider.user")},
+					{Key: "endpoint", FromFieldPath: ptr.To("status.atProvider.endpoint")},
+				},
+				o:   []ConnectionDetailExtractorOption{WithMissingSourcesSkipped()},
+				obj: observed,
+			},
+			want: want{
+				cd: managed.ConnectionDetails{"endpoint": []byte("db.example.org")},
+			},
+		},
+		"FromConnectionSecretKey": {
+			reason: "A connection secret key should be extracted from the supplied connection details.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "pass", FromConnectionSecretKey: ptr.To("password")}},
+				obj:   observed,
+				conn:  conn,
+			},
+			want: want{
+				cd: managed.ConnectionDetails{"pass": []byte("secret")},
+			},
+		},
+		"FromConnectionSecretKeyMissing": {
+			reason: "A missing connection secret key should return an error by default.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "user", FromConnectionSecretKey: ptr.To("username")}},
+				obj:   observed,
+				conn:  conn,
+			},
+			want: want{
+				err: errors.Errorf(errFmtMissingConnectionKey, "user", "username"),
+			},
+		},
+		"FromConnectionSecretKeyMissingSkipped": {
+			reason: "A missing connection secret key should be skipped if configured.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "user", FromConnectionSecretKey: ptr.To("username")}},
+				o:     []ConnectionDetailExtractorOption{WithMissingSourcesSkipped()},
+				obj:   observed,
+				conn:  conn,
+			},
+			want: want{
+				cd: managed.ConnectionDetails{},
+			},
+		},
+		"Value": {
+			reason: "A fixed value should be extracted as is.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "region", Value: ptr.To("us-east-1")}},
+				obj:   observed,
+			},
+			want: want{
+				cd: managed.ConnectionDetails{"region": []byte("us-east-1")},
+			},
+		},
+		"InvalidSpec": {
+			reason: "A spec with more than one source should return an error.",
+			args: args{
+				specs: []ConnectionDetailSpec{{Key: "region", Value: ptr.To("us-east-1"), FromConnectionSecretKey: ptr.To("region")}},
+				obj:   observed,
+			},
+			want: want{
+				err: errors.Errorf(errFmtInvalidDetailSpec, "region"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := NewConnectionDetailExtractor(tc.args.specs, tc.args.o...)
+			got, err := e.Extract(tc.args.obj, tc.args.conn)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nExtract(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cd, got); diff != "" {
+				t.Errorf("\n%s\nExtract(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
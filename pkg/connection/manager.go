@@ -35,12 +35,14 @@ import (
 
 // Error strings.
 const (
-	errConnectStore    = "cannot connect to secret store"
-	errWriteStore      = "cannot write to secret store"
-	errReadStore       = "cannot read from secret store"
-	errDeleteFromStore = "cannot delete from secret store"
-	errGetStoreConfig  = "cannot get store config"
-	errSecretConflict  = "cannot establish control of existing connection secret"
+	errConnectStore     = "cannot connect to secret store"
+	errWriteStore       = "cannot write to secret store"
+	errReadStore        = "cannot read from secret store"
+	errDeleteFromStore  = "cannot delete from secret store"
+	errGetStoreConfig   = "cannot get store config"
+	errNoStoreConfigRef = "publishConnectionDetailsTo.configRef must reference a store config"
+	errNoPublishTo      = "resource does not publish connection details"
+	errSecretConflict   = "cannot establish control of existing connection secret"
 
 	errFmtNotOwnedBy = "existing secret is not owned by UID %q"
 )
@@ -188,7 +190,23 @@ func (m *DetailsManager) PropagateConnection(ctx context.Context, to resource.Lo
 	return changed, errors.Wrap(err, errWriteStore)
 }
 
+// ResolveStore returns the Store that the supplied ConnectionSecretOwner
+// publishes its connection details to. The Store is built from the StoreConfig
+// referenced by the owner's publishConnectionDetailsTo.configRef, allowing each
+// resource to publish its connection details to a different store.
+func (m *DetailsManager) ResolveStore(ctx context.Context, so resource.ConnectionSecretOwner) (Store, error) {
+	p := so.GetPublishConnectionDetailsTo()
+	if p == nil {
+		return nil, errors.New(errNoPublishTo)
+	}
+	return m.connectStore(ctx, p)
+}
+
 func (m *DetailsManager) connectStore(ctx context.Context, p *v1.PublishConnectionDetailsTo) (Store, error) {
+	if p.SecretStoreConfigRef == nil || p.SecretStoreConfigRef.Name == "" {
+		return nil, errors.New(errNoStoreConfigRef)
+	}
+
 	sc := m.newConfig()
 	if err := m.client.Get(ctx, types.NamespacedName{Name: p.SecretStoreConfigRef.Name}, sc); err != nil {
 		return nil, errors.Wrap(err, errGetStoreConfig)
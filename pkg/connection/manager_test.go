@@ -90,6 +90,19 @@ func TestManagerConnectStore(t *testing.T) {
 				err: errors.Wrapf(kerrors.NewNotFound(schema.GroupResource{}, fakeConfig), errGetStoreConfig),
 			},
 		},
+		"NoConfigRef": {
+			reason: "We should return a clear error if no StoreConfig is referenced.",
+			args: args{
+				c: &test.MockClient{
+					MockScheme: test.NewMockSchemeFn(resourcefake.SchemeWith(&fake.StoreConfig{})),
+				},
+				sb: fakeStoreBuilderFn(fake.SecretStore{}),
+				p:  &v1.PublishConnectionDetailsTo{},
+			},
+			want: want{
+				err: errors.New(errNoStoreConfigRef),
+			},
+		},
 		"BuildStoreError": {
 			reason: "We should return any error encountered while building the Store.",
 			args: args{
@@ -154,6 +167,103 @@ func TestManagerConnectStore(t *testing.T) {
 	}
 }
 
+func TestManagerResolveStore(t *testing.T) {
+	resolved := &fake.SecretStore{}
+
+	type args struct {
+		c  client.Client
+		so resource.ConnectionSecretOwner
+	}
+
+	type want struct {
+		store Store
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args
+		want
+	}{
+		"NoPublishConnectionDetailsTo": {
+			reason: "We should return an error if the resource does not publish connection details.",
+			args: args{
+				c: &test.MockClient{
+					MockScheme: test.NewMockSchemeFn(resourcefake.SchemeWith(&fake.StoreConfig{})),
+				},
+				so: &resourcefake.MockConnectionSecretOwner{},
+			},
+			want: want{
+				err: errors.New(errNoPublishTo),
+			},
+		},
+		"ConfigNotFound": {
+			reason: "We should return an error if the referenced StoreConfig does not exist.",
+			args: args{
+				c: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, _ client.Object) error {
+						return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+					},
+					MockScheme: test.NewMockSchemeFn(resourcefake.SchemeWith(&fake.StoreConfig{})),
+				},
+				so: &resourcefake.MockConnectionSecretOwner{
+					To: &v1.PublishConnectionDetailsTo{
+						SecretStoreConfigRef: &v1.Reference{Name: fakeConfig},
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{}, fakeConfig), errGetStoreConfig),
+			},
+		},
+		"Resolved": {
+			reason: "We should return the Store configured by the referenced StoreConfig.",
+			args: args{
+				c: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if key.Name != fakeConfig {
+							return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+						}
+						*obj.(*fake.StoreConfig) = fake.StoreConfig{
+							ObjectMeta: metav1.ObjectMeta{Name: fakeConfig},
+							Config:     v1.SecretStoreConfig{Type: &fakeStore},
+						}
+						return nil
+					},
+					MockScheme: test.NewMockSchemeFn(resourcefake.SchemeWith(&fake.StoreConfig{})),
+				},
+				so: &resourcefake.MockConnectionSecretOwner{
+					To: &v1.PublishConnectionDetailsTo{
+						SecretStoreConfigRef: &v1.Reference{Name: fakeConfig},
+					},
+				},
+			},
+			want: want{
+				store: resolved,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sb := func(_ context.Context, _ client.Client, _ *tls.Config, cfg v1.SecretStoreConfig) (Store, error) {
+				if *cfg.Type == fakeStore {
+					return resolved, nil
+				}
+				return nil, errors.Errorf(errFmtUnknownSecretStore, *cfg.Type)
+			}
+			m := NewDetailsManager(tc.args.c, resourcefake.GVK(&fake.StoreConfig{}), WithStoreBuilder(sb))
+
+			got, err := m.ResolveStore(context.Background(), tc.args.so)
+			if got != tc.want.store {
+				t.Errorf("\nReason: %s\nm.ResolveStore(...): want store %v, got %v", tc.reason, tc.want.store, got)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nm.ResolveStore(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestManagerPublishConnection(t *testing.T) {
 	type args struct {
 		c  client.Client
@@ -0,0 +1,200 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errGetConfigMap    = "cannot get config map"
+	errDeleteConfigMap = "cannot delete config map"
+	errUpdateConfigMap = "cannot update config map"
+	errApplyConfigMap  = "cannot apply config map"
+)
+
+// A KeyClassifier returns true if the supplied connection detail key is
+// sensitive.
+type KeyClassifier func(key string) bool
+
+// NonSensitiveKeys returns a KeyClassifier that classifies the supplied keys
+// as non-sensitive, and all other keys as sensitive.
+func NonSensitiveKeys(keys ...string) KeyClassifier {
+	ns := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		ns[k] = true
+	}
+	return func(key string) bool { return !ns[key] }
+}
+
+// A ConfigMapSplittingStore stores sensitive key values in a Kubernetes
+// Secret, and non-sensitive key values in a Kubernetes ConfigMap with the same
+// name and namespace. This allows non-sensitive details, like a public
+// endpoint, to be read by those who aren't allowed to read Secrets. Reads
+// merge the key values of both.
+type ConfigMapSplittingStore struct {
+	secrets   *SecretStore
+	sensitive KeyClassifier
+}
+
+// NewConfigMapSplittingStore returns a store that uses the supplied
+// SecretStore for sensitive key values, and stores non-sensitive key values in
+// ConfigMaps using the same client and default namespace. The supplied
+// KeyClassifier determines which keys are sensitive.
+func NewConfigMapSplittingStore(secrets *SecretStore, sensitive KeyClassifier) *ConfigMapSplittingStore {
+	return &ConfigMapSplittingStore{secrets: secrets, sensitive: sensitive}
+}
+
+// ReadKeyValues reads and returns the key value pairs of a given Kubernetes
+// Secret and ConfigMap. Key values read from the Secret take precedence.
+func (ss *ConfigMapSplittingStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	if err := ss.secrets.ReadKeyValues(ctx, n, s); err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{}
+	if err := ss.secrets.client.Get(ctx, types.NamespacedName{Name: n.Name, Namespace: ss.secrets.namespaceForSecret(n)}, cm); resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errGetConfigMap)
+	}
+	if len(cm.Data)+len(cm.BinaryData) == 0 {
+		return nil
+	}
+	if s.Data == nil {
+		s.Data = store.KeyValues{}
+	}
+	for k, v := range cm.Data {
+		if _, ok := s.Data[k]; !ok {
+			s.Data[k] = []byte(v)
+		}
+	}
+	for k, v := range cm.BinaryData {
+		if _, ok := s.Data[k]; !ok {
+			s.Data[k] = v
+		}
+	}
+	return nil
+}
+
+// WriteKeyValues writes sensitive key value pairs to a given Kubernetes Secret,
+// and non-sensitive key value pairs to a Kubernetes ConfigMap. The supplied
+// write options apply only to the Secret. The ConfigMap is only written if
+// there are non-sensitive key values.
+func (ss *ConfigMapSplittingStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) {
+	sensitive, public := ss.split(s.Data)
+
+	secret := *s
+	secret.Data = sensitive
+	changed, err := ss.secrets.WriteKeyValues(ctx, &secret, wo...)
+	if err != nil {
+		return false, err
+	}
+	if len(public) == 0 {
+		return changed, nil
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.Name,
+			Namespace: ss.secrets.namespaceForSecret(s.ScopedName),
+		},
+		BinaryData: public,
+	}
+	if s.Metadata != nil {
+		cm.Labels = s.Metadata.Labels
+		cm.Annotations = s.Metadata.Annotations
+	}
+
+	err = ss.secrets.client.Apply(ctx, cm, resource.AllowUpdateIf(func(current, desired runtime.Object) bool {
+		// We consider the update to be a no-op and don't allow it if the
+		// current and existing config map data are identical.
+		return !cmp.Equal(current.(*corev1.ConfigMap).BinaryData, desired.(*corev1.ConfigMap).BinaryData, cmpopts.EquateEmpty()) //nolint:forcetypeassert // Will always be a config map.
+	}))
+	if resource.IsNotAllowed(err) {
+		// The update was not allowed because it was a no-op.
+		return changed, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errApplyConfigMap)
+	}
+	return true, nil
+}
+
+// DeleteKeyValues deletes key value pairs from a given Kubernetes Secret and
+// ConfigMap. If no key values are specified both are deleted. If key values are
+// specified those are deleted from the Secret or ConfigMap that stores them,
+// and either is deleted only if there is no data left in it.
+func (ss *ConfigMapSplittingStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	sensitive, public := ss.split(s.Data)
+
+	// Deleting a Secret with no key values would delete all of its key values,
+	// so we only do so if we were asked to delete all key values.
+	if len(s.Data) == 0 || len(sensitive) > 0 {
+		secret := *s
+		secret.Data = sensitive
+		if err := ss.secrets.DeleteKeyValues(ctx, &secret, do...); err != nil {
+			return err
+		}
+	}
+	if len(s.Data) > 0 && len(public) == 0 {
+		return nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	err := ss.secrets.client.Get(ctx, types.NamespacedName{Name: s.Name, Namespace: ss.secrets.namespaceForSecret(s.ScopedName)}, cm)
+	if kerrors.IsNotFound(err) {
+		// ConfigMap already deleted, nothing to do.
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetConfigMap)
+	}
+
+	for k := range public {
+		delete(cm.Data, k)
+		delete(cm.BinaryData, k)
+	}
+	if len(public) == 0 || len(cm.Data)+len(cm.BinaryData) == 0 {
+		return errors.Wrap(ss.secrets.client.Delete(ctx, cm), errDeleteConfigMap)
+	}
+	return errors.Wrap(ss.secrets.client.Update(ctx, cm), errUpdateConfigMap)
+}
+
+// split the supplied key values into those that are sensitive and those that
+// aren't.
+func (ss *ConfigMapSplittingStore) split(kv store.KeyValues) (sensitive, public store.KeyValues) {
+	sensitive, public = store.KeyValues{}, store.KeyValues{}
+	for k, v := range kv {
+		if ss.sensitive(k) {
+			sensitive[k] = v
+			continue
+		}
+		public[k] = v
+	}
+	return sensitive, public
+}
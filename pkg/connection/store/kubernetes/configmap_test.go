@@ -0,0 +1,248 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+func newSplittingStore(objs ...client.Object) (*ConfigMapSplittingStore, client.Client) {
+	c := ctrlfake.NewClientBuilder().WithObjects(objs...).Build()
+	ss := &SecretStore{
+		client: resource.ClientApplicator{
+			Client:     c,
+			Applicator: resource.NewAPIPatchingApplicator(c),
+		},
+		defaultNamespace: fakeSecretNamespace,
+	}
+	return NewConfigMapSplittingStore(ss, NonSensitiveKeys("endpoint", "region")), c
+}
+
+// stored returns the data stored in the fake Secret and ConfigMap, or nil if
+// either doesn't exist.
+func stored(t *testing.T, c client.Client) (secret, configMap map[string][]byte) {
+	t.Helper()
+	nn := types.NamespacedName{Name: fakeSecretName, Namespace: fakeSecretNamespace}
+	s := &corev1.Secret{}
+	if err := c.Get(context.Background(), nn, s); err == nil {
+		secret = s.Data
+	} else if !kerrors.IsNotFound(err) {
+		t.Fatalf("Get(...): %v", err)
+	}
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(context.Background(), nn, cm); err == nil {
+		configMap = cm.BinaryData
+	} else if !kerrors.IsNotFound(err) {
+		t.Fatalf("Get(...): %v", err)
+	}
+	return secret, configMap
+}
+
+func TestConfigMapSplittingStoreWriteKeyValues(t *testing.T) {
+	type want struct {
+		changed   bool
+		secret    map[string][]byte
+		configMap map[string][]byte
+	}
+
+	cases := map[string]struct {
+		reason string
+		kv     store.KeyValues
+		want   want
+	}{
+		"Split": {
+			reason: "Sensitive keys should be written to the Secret, and non-sensitive keys to the ConfigMap.",
+			kv: store.KeyValues{
+				"password": []byte("hunter2"),
+				"endpoint": []byte("db.example.org"),
+				"region":   []byte("us-east-1"),
+			},
+			want: want{
+				changed:   true,
+				secret:    map[string][]byte{"password": []byte("hunter2")},
+				configMap: map[string][]byte{"endpoint": []byte("db.example.org"), "region": []byte("us-east-1")},
+			},
+		},
+		"OnlySensitive": {
+			reason: "A ConfigMap shouldn't be written if there are no non-sensitive keys.",
+			kv: store.KeyValues{
+				"password": []byte("hunter2"),
+			},
+			want: want{
+				changed: true,
+				secret:  map[string][]byte{"password": []byte("hunter2")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss, c := newSplittingStore()
+			s := &store.Secret{ScopedName: store.ScopedName{Name: fakeSecretName}, Data: tc.kv}
+
+			changed, err := ss.WriteKeyValues(context.Background(), s)
+			if err != nil {
+				t.Fatalf("\n%s\nss.WriteKeyValues(...): unexpected error: %v", tc.reason, err)
+			}
+			got := want{changed: changed}
+			got.secret, got.configMap = stored(t, c)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nss.WriteKeyValues(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			// Writing the same key values again should be a no-op.
+			changed, err = ss.WriteKeyValues(context.Background(), s)
+			if err != nil {
+				t.Fatalf("\n%s\nss.WriteKeyValues(...): unexpected error: %v", tc.reason, err)
+			}
+			if changed {
+				t.Errorf("\n%s\nss.WriteKeyValues(...): want unchanged when rewriting the same key values", tc.reason)
+			}
+		})
+	}
+}
+
+func TestConfigMapSplittingStoreReadKeyValues(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: fakeSecretName, Namespace: fakeSecretNamespace}
+
+	cases := map[string]struct {
+		reason string
+		objs   []client.Object
+		want   store.KeyValues
+	}{
+		"Merged": {
+			reason: "Key values read from the Secret and ConfigMap should be merged.",
+			objs: []client.Object{
+				&corev1.Secret{ObjectMeta: meta, Data: map[string][]byte{"password": []byte("hunter2")}},
+				&corev1.ConfigMap{ObjectMeta: meta, BinaryData: map[string][]byte{"endpoint": []byte("db.example.org")}, Data: map[string]string{"region": "us-east-1"}},
+			},
+			want: store.KeyValues{
+				"password": []byte("hunter2"),
+				"endpoint": []byte("db.example.org"),
+				"region":   []byte("us-east-1"),
+			},
+		},
+		"SecretTakesPrecedence": {
+			reason: "Key values read from the Secret should take precedence over those read from the ConfigMap.",
+			objs: []client.Object{
+				&corev1.Secret{ObjectMeta: meta, Data: map[string][]byte{"endpoint": []byte("secret.example.org")}},
+				&corev1.ConfigMap{ObjectMeta: meta, BinaryData: map[string][]byte{"endpoint": []byte("db.example.org")}},
+			},
+			want: store.KeyValues{
+				"endpoint": []byte("secret.example.org"),
+			},
+		},
+		"OnlyConfigMap": {
+			reason: "Key values should be read from the ConfigMap even if there's no Secret.",
+			objs: []client.Object{
+				&corev1.ConfigMap{ObjectMeta: meta, BinaryData: map[string][]byte{"endpoint": []byte("db.example.org")}},
+			},
+			want: store.KeyValues{
+				"endpoint": []byte("db.example.org"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss, _ := newSplittingStore(tc.objs...)
+			s := &store.Secret{}
+			if err := ss.ReadKeyValues(context.Background(), store.ScopedName{Name: fakeSecretName}, s); err != nil {
+				t.Fatalf("\n%s\nss.ReadKeyValues(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, s.Data); diff != "" {
+				t.Errorf("\n%s\nss.ReadKeyValues(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConfigMapSplittingStoreDeleteKeyValues(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: fakeSecretName, Namespace: fakeSecretNamespace}
+	objs := func() []client.Object {
+		return []client.Object{
+			&corev1.Secret{ObjectMeta: meta, Data: map[string][]byte{"password": []byte("hunter2"), "token": []byte("t0k3n")}},
+			&corev1.ConfigMap{ObjectMeta: meta, BinaryData: map[string][]byte{"endpoint": []byte("db.example.org"), "region": []byte("us-east-1")}},
+		}
+	}
+
+	type want struct {
+		secret    map[string][]byte
+		configMap map[string][]byte
+	}
+
+	cases := map[string]struct {
+		reason string
+		kv     store.KeyValues
+		want   want
+	}{
+		"All": {
+			reason: "Both the Secret and ConfigMap should be deleted if no key values are supplied.",
+			want:   want{},
+		},
+		"NonSensitiveKey": {
+			reason: "Deleting a non-sensitive key should only remove it from the ConfigMap.",
+			kv:     store.KeyValues{"region": nil},
+			want: want{
+				secret:    map[string][]byte{"password": []byte("hunter2"), "token": []byte("t0k3n")},
+				configMap: map[string][]byte{"endpoint": []byte("db.example.org")},
+			},
+		},
+		"SensitiveKey": {
+			reason: "Deleting a sensitive key should only remove it from the Secret.",
+			kv:     store.KeyValues{"token": nil},
+			want: want{
+				secret:    map[string][]byte{"password": []byte("hunter2")},
+				configMap: map[string][]byte{"endpoint": []byte("db.example.org"), "region": []byte("us-east-1")},
+			},
+		},
+		"LastNonSensitiveKeys": {
+			reason: "The ConfigMap should be deleted when no data is left in it.",
+			kv:     store.KeyValues{"endpoint": nil, "region": nil},
+			want: want{
+				secret: map[string][]byte{"password": []byte("hunter2"), "token": []byte("t0k3n")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss, c := newSplittingStore(objs()...)
+			s := &store.Secret{ScopedName: store.ScopedName{Name: fakeSecretName}, Data: tc.kv}
+			if err := ss.DeleteKeyValues(context.Background(), s); err != nil {
+				t.Fatalf("\n%s\nss.DeleteKeyValues(...): unexpected error: %v", tc.reason, err)
+			}
+			got := want{}
+			got.secret, got.configMap = stored(t, c)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nss.DeleteKeyValues(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
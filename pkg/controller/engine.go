@@ -0,0 +1,213 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// Error strings.
+const (
+	errCreateController = "cannot create controller"
+	errCreateCache      = "cannot create cache"
+	errWatch            = "cannot watch resources"
+)
+
+// A NewControllerFn returns a new, unstarted controller that isn't managed by
+// the supplied manager.
+type NewControllerFn func(name string, mgr manager.Manager, o controller.Options) (controller.Controller, error)
+
+// A NewCacheFn returns a new cache.
+type NewCacheFn func(cfg *rest.Config, o cache.Options) (cache.Cache, error)
+
+// A Watch configures a controller started by an Engine to watch a kind of
+// resource.
+type Watch struct {
+	kind       client.Object
+	handler    handler.EventHandler
+	predicates []predicate.Predicate
+}
+
+// WatchFor returns a Watch for the supplied kind of resource. Events are
+// handled by the supplied handler if they pass the supplied predicates.
+func WatchFor(kind client.Object, h handler.EventHandler, p ...predicate.Predicate) Watch {
+	return Watch{kind: kind, handler: h, predicates: p}
+}
+
+// An Engine starts and stops controllers at runtime, for example controllers
+// for kinds of resource that are installed after the manager starts. Each
+// controller watches resources using its own cache, so stopping a controller
+// also stops its watches.
+type Engine struct {
+	mgr           manager.Manager
+	newController NewControllerFn
+	newCache      NewCacheFn
+	log           logging.Logger
+
+	mx      sync.Mutex
+	running map[string]*runningController
+}
+
+type runningController struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// An EngineOption configures an Engine.
+type EngineOption func(*Engine)
+
+// WithNewControllerFn configures how an Engine creates controllers. Engines
+// create unmanaged controller-runtime controllers by default.
+func WithNewControllerFn(fn NewControllerFn) EngineOption {
+	return func(e *Engine) {
+		e.newController = fn
+	}
+}
+
+// WithNewCacheFn configures how an Engine creates the caches its controllers
+// use to watch resources.
+func WithNewCacheFn(fn NewCacheFn) EngineOption {
+	return func(e *Engine) {
+		e.newCache = fn
+	}
+}
+
+// WithLogger configures the logger an Engine uses.
+func WithLogger(l logging.Logger) EngineOption {
+	return func(e *Engine) {
+		e.log = l
+	}
+}
+
+// NewEngine returns an Engine that starts controllers against the supplied
+// manager.
+func NewEngine(mgr manager.Manager, o ...EngineOption) *Engine {
+	e := &Engine{
+		mgr:           mgr,
+		newController: controller.NewUnmanaged,
+		newCache:      cache.New,
+		log:           logging.NewNopLogger(),
+		running:       make(map[string]*runningController),
+	}
+	for _, fn := range o {
+		fn(e)
+	}
+	return e
+}
+
+// IsRunning returns true if the named controller is running.
+func (e *Engine) IsRunning(name string) bool {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	_, ok := e.running[name]
+	return ok
+}
+
+// Start the named controller with the supplied options and watches. The
+// controller runs until the supplied context is done, or until it's stopped
+// using Stop. Starting a controller that is already running is a no-op.
+func (e *Engine) Start(ctx context.Context, name string, o controller.Options, w ...Watch) error {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+
+	if _, ok := e.running[name]; ok {
+		return nil
+	}
+
+	// The Engine ensures only one controller with each name runs at a time.
+	// Controller-runtime would otherwise refuse to create a controller with
+	// the name of one that was previously stopped.
+	if o.SkipNameValidation == nil {
+		o.SkipNameValidation = ptr.To(true)
+	}
+
+	c, err := e.newController(name, e.mgr, o)
+	if err != nil {
+		return errors.Wrap(err, errCreateController)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	if len(w) > 0 {
+		ca, err := e.newCache(e.mgr.GetConfig(), cache.Options{Scheme: e.mgr.GetScheme(), Mapper: e.mgr.GetRESTMapper()})
+		if err != nil {
+			cancel()
+			return errors.Wrap(err, errCreateCache)
+		}
+		for _, wt := range w {
+			if err := c.Watch(source.Kind(ca, wt.kind, wt.handler, wt.predicates...)); err != nil {
+				cancel()
+				return errors.Wrap(err, errWatch)
+			}
+		}
+		go func() {
+			if err := ca.Start(ctx); err != nil {
+				e.log.Info("Cannot start controller cache", "controller", name, "error", err)
+			}
+		}()
+	}
+
+	rc := &runningController{cancel: cancel, done: make(chan struct{})}
+	e.running[name] = rc
+
+	go func() {
+		defer close(rc.done)
+		if err := c.Start(ctx); err != nil {
+			e.log.Info("Controller stopped with an error", "controller", name, "error", err)
+		}
+
+		// The controller may have stopped by itself, so we make sure the
+		// Engine no longer considers it running.
+		cancel()
+		e.mx.Lock()
+		if e.running[name] == rc {
+			delete(e.running, name)
+		}
+		e.mx.Unlock()
+	}()
+
+	return nil
+}
+
+// Stop the named controller, and wait for it to stop. Its watches are stopped
+// too. Stopping a controller that isn't running is a no-op.
+func (e *Engine) Stop(name string) {
+	e.mx.Lock()
+	rc, ok := e.running[name]
+	delete(e.running, name)
+	e.mx.Unlock()
+
+	if !ok {
+		return
+	}
+	rc.cancel()
+	<-rc.done
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var _ controller.Controller = &mockController{}
+
+// A mockController reconciles requests sent to its queue until it's stopped.
+type mockController struct {
+	reconcile.Reconciler
+
+	queue   chan reconcile.Request
+	started chan context.Context
+}
+
+func (c *mockController) Watch(_ source.Source) error { return nil }
+
+func (c *mockController) GetLogger() logr.Logger { return logr.Discard() }
+
+func (c *mockController) Start(ctx context.Context) error {
+	c.started <- ctx
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case req := <-c.queue:
+			_, _ = c.Reconcile(ctx, req)
+		}
+	}
+}
+
+func TestEngineStartStop(t *testing.T) {
+	reconciled := make(chan reconcile.Request)
+	c := &mockController{
+		Reconciler: reconcile.Func(func(_ context.Context, req reconcile.Request) (reconcile.Result, error) {
+			reconciled <- req
+			return reconcile.Result{}, nil
+		}),
+		queue:   make(chan reconcile.Request),
+		started: make(chan context.Context, 1),
+	}
+	e := NewEngine(&fake.Manager{}, WithNewControllerFn(func(_ string, _ manager.Manager, _ controller.Options) (controller.Controller, error) {
+		return c, nil
+	}))
+
+	if err := e.Start(context.Background(), "cool", controller.Options{}); err != nil {
+		t.Fatalf("e.Start(...): unexpected error: %v", err)
+	}
+	ctx := <-c.started
+	if !e.IsRunning("cool") {
+		t.Errorf("e.IsRunning(...): want true after Start, got false")
+	}
+
+	// The controller should reconcile while it's running.
+	req := reconcile.Request{}
+	req.Name = "cool-resource"
+	c.queue <- req
+	if diff := cmp.Diff(req, <-reconciled); diff != "" {
+		t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+	}
+
+	e.Stop("cool")
+	if diff := cmp.Diff(context.Canceled, ctx.Err(), test.EquateErrors()); diff != "" {
+		t.Errorf("e.Stop(...): -want controller context error, +got controller context error:\n%s", diff)
+	}
+	if e.IsRunning("cool") {
+		t.Errorf("e.IsRunning(...): want false after Stop, got true")
+	}
+
+	// Stop waits for the controller to return, so nothing should be receiving
+	// from its queue.
+	select {
+	case c.queue <- req:
+		t.Errorf("e.Stop(...): controller should not reconcile after it's stopped")
+	default:
+	}
+}
+
+func TestEngineStart(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		o []EngineOption
+		w []Watch
+	}
+	type want struct {
+		err     error
+		running bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NewControllerError": {
+			reason: "We should return any error encountered creating the controller.",
+			args: args{
+				o: []EngineOption{
+					WithNewControllerFn(func(_ string, _ manager.Manager, _ controller.Options) (controller.Controller, error) {
+						return nil, errBoom
+					}),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateController),
+			},
+		},
+		"NewCacheError": {
+			reason: "We should return any error encountered creating the cache used to watch resources.",
+			args: args{
+				o: []EngineOption{
+					WithNewControllerFn(func(_ string, _ manager.Manager, _ controller.Options) (controller.Controller, error) {
+						return &mockController{started: make(chan context.Context, 1)}, nil
+					}),
+					WithNewCacheFn(func(_ *rest.Config, _ cache.Options) (cache.Cache, error) {
+						return nil, errBoom
+					}),
+				},
+				w: []Watch{WatchFor(&fake.Managed{}, &handler.EnqueueRequestForObject{})},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateCache),
+			},
+		},
+		"Success": {
+			reason: "A controller without watches should be started.",
+			args: args{
+				o: []EngineOption{
+					WithNewControllerFn(func(_ string, _ manager.Manager, _ controller.Options) (controller.Controller, error) {
+						return &mockController{started: make(chan context.Context, 1)}, nil
+					}),
+				},
+			},
+			want: want{
+				running: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := NewEngine(&fake.Manager{}, tc.args.o...)
+			err := e.Start(context.Background(), "cool", controller.Options{}, tc.args.w...)
+			defer e.Stop("cool")
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Start(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.running, e.IsRunning("cool")); diff != "" {
+				t.Errorf("\n%s\ne.IsRunning(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -14,7 +14,8 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package controller configures controller options.
+// Package controller configures controller options, and starts and stops
+// controllers at runtime.
 package controller
 
 import (
@@ -23,6 +24,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/feature"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
@@ -82,6 +84,24 @@ func (o Options) ForControllerRuntime() controller.Options {
 	}
 }
 
+// ForManagedReconciler extracts the configuration shared by managed resource
+// reconcilers. The supplied recorder is used to record events.
+func (o Options) ForManagedReconciler(r event.Recorder) managed.ReconcilerConfig {
+	c := managed.ReconcilerConfig{
+		Logger:       o.Logger,
+		Recorder:     r,
+		PollInterval: o.PollInterval,
+		Features:     o.Features,
+	}
+	if o.MetricOptions != nil {
+		c.MetricRecorder = o.MetricOptions.MRMetrics
+	}
+	if o.ChangeLogOptions != nil {
+		c.ChangeLogger = o.ChangeLogOptions.ChangeLogger
+	}
+	return c
+}
+
 // ESSOptions for External Secret Stores.
 type ESSOptions struct {
 	TLSConfig     *tls.Config
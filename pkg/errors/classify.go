@@ -0,0 +1,179 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// A grpcStatuser is an error that carries a gRPC status, such as those
+// returned by gRPC clients.
+type grpcStatuser interface {
+	GRPCStatus() *status.Status
+}
+
+// An httpStatusCoder is an error that carries an HTTP status code. Several
+// cloud provider SDKs return errors that satisfy one of these interfaces.
+type httpStatusCoder interface {
+	StatusCode() int
+}
+
+type httpStatusCodeGetter interface {
+	HTTPStatusCode() int
+}
+
+// grpcCode returns the gRPC status code of the first error in err's chain
+// that carries one.
+func grpcCode(err error) (codes.Code, bool) {
+	var s grpcStatuser
+	if !errors.As(err, &s) || s.GRPCStatus() == nil {
+		return codes.OK, false
+	}
+	return s.GRPCStatus().Code(), true
+}
+
+// httpCode returns the HTTP status code of the first error in err's chain
+// that carries one.
+func httpCode(err error) (int, bool) {
+	var sc httpStatusCoder
+	if errors.As(err, &sc) {
+		return sc.StatusCode(), true
+	}
+	var hsc httpStatusCodeGetter
+	if errors.As(err, &hsc) {
+		return hsc.HTTPStatusCode(), true
+	}
+	return 0, false
+}
+
+// IsNotFound returns true if err, or any error it wraps, indicates that a
+// resource was not found. It recognizes Kubernetes API errors, gRPC status
+// errors, and errors that carry an HTTP status code.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	if kerrors.IsNotFound(err) {
+		return true
+	}
+	if c, ok := grpcCode(err); ok {
+		return c == codes.NotFound
+	}
+	c, ok := httpCode(err)
+	return ok && c == http.StatusNotFound
+}
+
+// IsConflict returns true if err, or any error it wraps, indicates that a
+// request conflicted with the current state of a resource. It recognizes
+// Kubernetes API errors, gRPC status errors, and errors that carry an HTTP
+// status code.
+func IsConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	if kerrors.IsConflict(err) {
+		return true
+	}
+	if c, ok := grpcCode(err); ok {
+		return c == codes.Aborted
+	}
+	c, ok := httpCode(err)
+	return ok && c == http.StatusConflict
+}
+
+// IsForbidden returns true if err, or any error it wraps, indicates that a
+// request was not permitted. It recognizes Kubernetes API errors, gRPC status
+// errors, and errors that carry an HTTP status code.
+func IsForbidden(err error) bool {
+	if err == nil {
+		return false
+	}
+	if kerrors.IsForbidden(err) {
+		return true
+	}
+	if c, ok := grpcCode(err); ok {
+		return c == codes.PermissionDenied
+	}
+	c, ok := httpCode(err)
+	return ok && c == http.StatusForbidden
+}
+
+// IsThrottled returns true if err, or any error it wraps, indicates that a
+// request was rejected because the caller is being rate limited. It recognizes
+// Kubernetes API errors, gRPC status errors, and errors that carry an HTTP
+// status code.
+func IsThrottled(err error) bool {
+	if err == nil {
+		return false
+	}
+	if kerrors.IsTooManyRequests(err) {
+		return true
+	}
+	if c, ok := grpcCode(err); ok {
+		return c == codes.ResourceExhausted
+	}
+	c, ok := httpCode(err)
+	return ok && c == http.StatusTooManyRequests
+}
+
+// IsRetryable returns true if err, or any error it wraps, indicates a
+// transient failure that may succeed if the request is retried. This includes
+// conflicts, timeouts, throttling, and server errors. It recognizes
+// Kubernetes API errors, gRPC status errors, errors that carry an HTTP status
+// code, context deadlines, and network timeouts.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if kerrors.IsConflict(err) ||
+		kerrors.IsServerTimeout(err) ||
+		kerrors.IsTimeout(err) ||
+		kerrors.IsTooManyRequests(err) ||
+		kerrors.IsServiceUnavailable(err) ||
+		kerrors.IsInternalError(err) ||
+		kerrors.IsUnexpectedServerError(err) {
+		return true
+	}
+	if c, ok := grpcCode(err); ok {
+		switch c { //nolint:exhaustive // Other codes aren't retryable.
+		case codes.Aborted, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Unavailable:
+			return true
+		}
+		return false
+	}
+	if c, ok := httpCode(err); ok {
+		switch {
+		case c == http.StatusRequestTimeout, c == http.StatusConflict, c == http.StatusTooManyRequests:
+			return true
+		case c >= http.StatusInternalServerError && c != http.StatusNotImplemented:
+			return true
+		}
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type httpError struct{ code int }
+
+func (e httpError) Error() string   { return http.StatusText(e.code) }
+func (e httpError) StatusCode() int { return e.code }
+
+type sdkError struct{ code int }
+
+func (e sdkError) Error() string       { return http.StatusText(e.code) }
+func (e sdkError) HTTPStatusCode() int { return e.code }
+
+func TestClassify(t *testing.T) {
+	gr := schema.GroupResource{Group: "example.org", Resource: "coolresources"}
+
+	type want struct {
+		notFound  bool
+		conflict  bool
+		forbidden bool
+		throttled bool
+		retryable bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"Nil": {
+			reason: "A nil error should match nothing.",
+		},
+		"NonMatching": {
+			reason: "An error we can't classify should match nothing.",
+			err:    Wrap(New("boom"), "cannot do the thing"),
+		},
+		"WrappedKubernetesNotFound": {
+			reason: "A wrapped Kubernetes not found error should be recognized.",
+			err:    Wrap(Wrap(kerrors.NewNotFound(gr, "cool"), "cannot get"), "cannot reconcile"),
+			want:   want{notFound: true},
+		},
+		"WrappedKubernetesConflict": {
+			reason: "A wrapped Kubernetes conflict error should be recognized, and is retryable.",
+			err:    Wrap(kerrors.NewConflict(gr, "cool", New("stale")), "cannot update"),
+			want:   want{conflict: true, retryable: true},
+		},
+		"WrappedKubernetesForbidden": {
+			reason: "A wrapped Kubernetes forbidden error should be recognized.",
+			err:    Wrap(kerrors.NewForbidden(gr, "cool", New("nope")), "cannot update"),
+			want:   want{forbidden: true},
+		},
+		"WrappedKubernetesTooManyRequests": {
+			reason: "A wrapped Kubernetes throttling error should be recognized, and is retryable.",
+			err:    Wrap(kerrors.NewTooManyRequests("slow down", 1), "cannot list"),
+			want:   want{throttled: true, retryable: true},
+		},
+		"WrappedKubernetesInvalid": {
+			reason: "A wrapped Kubernetes validation error should not be retryable.",
+			err:    Wrap(kerrors.NewBadRequest("bad"), "cannot create"),
+		},
+		"WrappedGRPCNotFound": {
+			reason: "A wrapped gRPC not found status should be recognized.",
+			err:    Wrap(status.Error(codes.NotFound, "nope"), "cannot get"),
+			want:   want{notFound: true},
+		},
+		"WrappedGRPCPermissionDenied": {
+			reason: "A wrapped gRPC permission denied status should be recognized as forbidden.",
+			err:    Wrap(status.Error(codes.PermissionDenied, "nope"), "cannot get"),
+			want:   want{forbidden: true},
+		},
+		"WrappedGRPCUnavailable": {
+			reason: "A wrapped gRPC unavailable status should be retryable.",
+			err:    Wrap(status.Error(codes.Unavailable, "down"), "cannot get"),
+			want:   want{retryable: true},
+		},
+		"WrappedGRPCResourceExhausted": {
+			reason: "A wrapped gRPC resource exhausted status should be recognized as throttling, and is retryable.",
+			err:    Wrap(status.Error(codes.ResourceExhausted, "slow down"), "cannot get"),
+			want:   want{throttled: true, retryable: true},
+		},
+		"WrappedHTTPTooManyRequests": {
+			reason: "A wrapped error carrying an HTTP 429 should be recognized as throttling, and is retryable.",
+			err:    Wrap(httpError{code: http.StatusTooManyRequests}, "cannot get"),
+			want:   want{throttled: true, retryable: true},
+		},
+		"WrappedHTTPNotFound": {
+			reason: "A wrapped error carrying an HTTP 404 should be recognized.",
+			err:    Wrap(httpError{code: http.StatusNotFound}, "cannot get"),
+			want:   want{notFound: true},
+		},
+		"WrappedHTTPConflict": {
+			reason: "A wrapped error carrying an HTTP 409 should be recognized, and is retryable.",
+			err:    Wrap(sdkError{code: http.StatusConflict}, "cannot update"),
+			want:   want{conflict: true, retryable: true},
+		},
+		"WrappedHTTPServiceUnavailable": {
+			reason: "A wrapped error carrying an HTTP 503 should be retryable.",
+			err:    Wrap(sdkError{code: http.StatusServiceUnavailable}, "cannot update"),
+			want:   want{retryable: true},
+		},
+		"WrappedHTTPNotImplemented": {
+			reason: "A wrapped error carrying an HTTP 501 should not be retryable.",
+			err:    Wrap(httpError{code: http.StatusNotImplemented}, "cannot update"),
+		},
+		"WrappedDeadlineExceeded": {
+			reason: "A wrapped context deadline should be retryable.",
+			err:    Wrap(context.DeadlineExceeded, "cannot get"),
+			want:   want{retryable: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{
+				notFound:  IsNotFound(tc.err),
+				conflict:  IsConflict(tc.err),
+				forbidden: IsForbidden(tc.err),
+				throttled: IsThrottled(tc.err),
+				retryable: IsRetryable(tc.err),
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nClassify(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -85,9 +85,20 @@ func NewAPIRecorder(r record.EventRecorder) *APIRecorder {
 	return &APIRecorder{kube: r, annotations: map[string]string{}}
 }
 
-// Event records the supplied event.
+// Event records the supplied event. The event's annotations are recorded in
+// addition to the recorder's.
 func (r *APIRecorder) Event(obj runtime.Object, e Event) {
-	r.kube.AnnotatedEventf(obj, r.annotations, string(e.Type), string(e.Reason), e.Message)
+	a := r.annotations
+	if len(e.Annotations) > 0 {
+		a = make(map[string]string, len(r.annotations)+len(e.Annotations))
+		for k, v := range r.annotations {
+			a[k] = v
+		}
+		for k, v := range e.Annotations {
+			a[k] = v
+		}
+	}
+	r.kube.AnnotatedEventf(obj, a, string(e.Type), string(e.Reason), e.Message)
 }
 
 // WithAnnotations returns a new *APIRecorder that includes the supplied
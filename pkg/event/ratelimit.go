@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// AnnotationKeyCoalescedCount is the annotation of a recorded event that
+// records how many identical events were coalesced into it.
+const AnnotationKeyCoalescedCount = "event.crossplane.io/coalesced-count"
+
+// The number of shards rate limit state is split into, to reduce contention.
+const rateLimitShards = 32
+
+// A RateLimitedRecorder records an event only if an identical event was not
+// recorded for the same object within a window. Identical events are those
+// with the same type, reason, and message. Identical events recorded within
+// the window are coalesced; they're counted, and the next identical event
+// recorded after the window has passed carries their count in its
+// AnnotationKeyCoalescedCount annotation. The count is forgotten if no
+// identical event is recorded within the window of the last one.
+type RateLimitedRecorder struct {
+	recorder Recorder
+	window   time.Duration
+	state    *rateLimitState
+}
+
+type eventKey struct {
+	object  types.UID
+	name    types.NamespacedName
+	kind    string
+	typ     Type
+	reason  Reason
+	message string
+}
+
+type rateLimitEntry struct {
+	recorded  time.Time
+	seen      time.Time
+	coalesced int
+}
+
+type rateLimitShard struct {
+	mu        sync.Mutex
+	recorded  map[eventKey]*rateLimitEntry
+	lastSweep time.Time
+}
+
+// rateLimitState is shared by a RateLimitedRecorder and any recorders derived
+// from it using WithAnnotations. Reconcilers typically derive a recorder each
+// time they reconcile an object. The state is sharded by object, so that
+// events for different objects rarely contend for the same lock.
+type rateLimitState struct {
+	now    func() time.Time
+	shards [rateLimitShards]rateLimitShard
+}
+
+// NewRateLimited returns a Recorder that passes events to the supplied Recorder
+// unless an identical event was recorded for the same object within the
+// supplied window.
+func NewRateLimited(r Recorder, window time.Duration) *RateLimitedRecorder {
+	s := &rateLimitState{now: time.Now}
+	for i := range s.shards {
+		s.shards[i].recorded = map[eventKey]*rateLimitEntry{}
+	}
+	return &RateLimitedRecorder{recorder: r, window: window, state: s}
+}
+
+// Event records the supplied event, unless an identical event was recorded for
+// the supplied object within the window.
+func (r *RateLimitedRecorder) Event(obj runtime.Object, e Event) {
+	k := eventKey{kind: obj.GetObjectKind().GroupVersionKind().String(), typ: e.Type, reason: e.Reason, message: e.Message}
+	if m, err := meta.Accessor(obj); err == nil {
+		k.object = m.GetUID()
+		k.name = types.NamespacedName{Namespace: m.GetNamespace(), Name: m.GetName()}
+	}
+
+	ok, coalesced := r.state.allow(k, r.window)
+	if !ok {
+		return
+	}
+	if coalesced > 0 {
+		a := make(map[string]string, len(e.Annotations)+1)
+		for k, v := range e.Annotations {
+			a[k] = v
+		}
+		a[AnnotationKeyCoalescedCount] = strconv.Itoa(coalesced)
+		e.Annotations = a
+	}
+	r.recorder.Event(obj, e)
+}
+
+// allow returns true if the event with the supplied key should be recorded,
+// and how many identical events were coalesced since it was last recorded.
+func (s *rateLimitState) allow(k eventKey, window time.Duration) (bool, int) {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(k.object))
+	_, _ = h.Write([]byte(k.name.String()))
+	sh := &s.shards[h.Sum32()%rateLimitShards]
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	now := s.now()
+
+	// Forget events that weren't seen within the window, so that we don't
+	// grow without bound. We do so at most once per window, so that the cost
+	// of doing so is amortized across the events recorded within it.
+	if now.Sub(sh.lastSweep) >= window {
+		for rk, e := range sh.recorded {
+			if now.Sub(e.seen) >= window {
+				delete(sh.recorded, rk)
+			}
+		}
+		sh.lastSweep = now
+	}
+
+	e, ok := sh.recorded[k]
+	if ok && now.Sub(e.recorded) < window {
+		e.seen = now
+		e.coalesced++
+		return false, 0
+	}
+	coalesced := 0
+	if ok {
+		coalesced = e.coalesced
+	}
+	sh.recorded[k] = &rateLimitEntry{recorded: now, seen: now}
+	return true, coalesced
+}
+
+// WithAnnotations returns a new *RateLimitedRecorder that includes the supplied
+// annotations with all recorded events. The new recorder shares its rate
+// limits with this one.
+func (r *RateLimitedRecorder) WithAnnotations(keysAndValues ...string) Recorder {
+	return &RateLimitedRecorder{
+		recorder: r.recorder.WithAnnotations(keysAndValues...),
+		window:   r.window,
+		state:    r.state,
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ Recorder = &RateLimitedRecorder{}
+
+type recorded struct {
+	Name  string
+	Event Event
+}
+
+type capturingRecorder struct {
+	events *[]recorded
+}
+
+func (r capturingRecorder) Event(obj runtime.Object, e Event) {
+	*r.events = append(*r.events, recorded{Name: obj.(metav1.Object).GetName(), Event: e})
+}
+
+func (r capturingRecorder) WithAnnotations(_ ...string) Recorder { return r }
+
+func TestRateLimitedRecorder(t *testing.T) {
+	now := time.Now()
+	window := time.Minute
+
+	a := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "a", UID: types.UID("a")}}
+	b := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "b", UID: types.UID("b")}}
+	boom := Event{Type: TypeWarning, Reason: "Boom", Message: "boom"}
+	boom2 := Event{Type: TypeWarning, Reason: "Boom", Message: "boom", Annotations: map[string]string{AnnotationKeyCoalescedCount: "2"}}
+	bang := Event{Type: TypeWarning, Reason: "Boom", Message: "bang"}
+
+	type record struct {
+		after time.Duration
+		obj   runtime.Object
+		e     Event
+	}
+
+	cases := map[string]struct {
+		reason  string
+		records []record
+		want    []recorded
+	}{
+		"IdenticalWithinWindow": {
+			reason: "N identical events within the window should produce one recorded event.",
+			records: []record{
+				{after: 0, obj: a, e: boom},
+				{after: time.Second, obj: a, e: boom},
+				{after: 2 * time.Second, obj: a, e: boom},
+				{after: 59 * time.Second, obj: a, e: boom},
+			},
+			want: []recorded{{Name: "a", Event: boom}},
+		},
+		"IdenticalOutsideWindow": {
+			reason: "An identical event recorded after the window has passed should be recorded.",
+			records: []record{
+				{after: 0, obj: a, e: boom},
+				{after: time.Minute, obj: a, e: boom},
+			},
+			want: []recorded{{Name: "a", Event: boom}, {Name: "a", Event: boom}},
+		},
+		"CoalescedOutsideWindow": {
+			reason: "An identical event recorded after the window has passed should carry the count of coalesced events.",
+			records: []record{
+				{after: 0, obj: a, e: boom},
+				{after: time.Second, obj: a, e: boom},
+				{after: 2 * time.Second, obj: a, e: boom},
+				{after: time.Minute, obj: a, e: boom},
+			},
+			want: []recorded{{Name: "a", Event: boom}, {Name: "a", Event: boom2}},
+		},
+		"CoalescedForgotten": {
+			reason: "Coalesced events should be forgotten if no identical event is recorded within the window of the last.",
+			records: []record{
+				{after: 0, obj: a, e: boom},
+				{after: time.Second, obj: a, e: boom},
+				{after: 2 * time.Minute, obj: a, e: boom},
+			},
+			want: []recorded{{Name: "a", Event: boom}, {Name: "a", Event: boom}},
+		},
+		"DifferentMessage": {
+			reason: "Events with different messages should each be recorded.",
+			records: []record{
+				{after: 0, obj: a, e: boom},
+				{after: time.Second, obj: a, e: bang},
+			},
+			want: []recorded{{Name: "a", Event: boom}, {Name: "a", Event: bang}},
+		},
+		"DifferentObject": {
+			reason: "Identical events for different objects should each be recorded.",
+			records: []record{
+				{after: 0, obj: a, e: boom},
+				{after: time.Second, obj: b, e: boom},
+			},
+			want: []recorded{{Name: "a", Event: boom}, {Name: "b", Event: boom}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := make([]recorded, 0)
+			r := NewRateLimited(capturingRecorder{events: &got}, window)
+
+			for _, rec := range tc.records {
+				r.state.now = func() time.Time { return now.Add(rec.after) }
+
+				// Reconcilers typically derive an annotated recorder each
+				// time they reconcile, so we do too.
+				r.WithAnnotations("cool", "very").Event(rec.obj, rec.e)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nEvent(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
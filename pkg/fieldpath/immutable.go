@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errPaveOld                   = "cannot pave old object"
+	errPaveNew                   = "cannot pave new object"
+	errFmtExpandImmutable        = "cannot expand immutable field path %q"
+	errFmtGetImmutable           = "cannot get immutable field %q"
+	errFmtImmutableFieldsChanged = "immutable fields cannot be changed: %s"
+)
+
+// ValidateImmutableFields returns an error if any of the fields at the supplied
+// paths differ between the old and new objects. Objects are compared as JSON.
+// Paths may contain wildcards. A field that is set in only one of the objects
+// is considered changed. The error lists the paths of all changed fields, so
+// it's suitable for returning from an admission webhook or surfacing as a
+// condition.
+func ValidateImmutableFields(oldObj, newObj any, paths ...string) error {
+	o, err := paveAny(oldObj)
+	if err != nil {
+		return errors.Wrap(err, errPaveOld)
+	}
+	n, err := paveAny(newObj)
+	if err != nil {
+		return errors.Wrap(err, errPaveNew)
+	}
+
+	changed := map[string]bool{}
+	for _, path := range paths {
+		expanded := map[string]bool{}
+		for _, p := range []*Paved{o, n} {
+			ps, err := p.ExpandWildcards(path)
+			if err != nil && !IsNotFound(err) {
+				return errors.Wrapf(err, errFmtExpandImmutable, path)
+			}
+			for _, e := range ps {
+				expanded[e] = true
+			}
+		}
+		for e := range expanded {
+			ov, err := o.GetValue(e)
+			if err != nil && !IsNotFound(err) {
+				return errors.Wrapf(err, errFmtGetImmutable, e)
+			}
+			nv, err := n.GetValue(e)
+			if err != nil && !IsNotFound(err) {
+				return errors.Wrapf(err, errFmtGetImmutable, e)
+			}
+			if !reflect.DeepEqual(ov, nv) {
+				changed[e] = true
+			}
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	sorted := make([]string, 0, len(changed))
+	for p := range changed {
+		sorted = append(sorted, p)
+	}
+	sort.Strings(sorted)
+	return errors.Errorf(errFmtImmutableFieldsChanged, strings.Join(sorted, ", "))
+}
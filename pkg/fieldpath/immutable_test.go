@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestValidateImmutableFields(t *testing.T) {
+	obj := func(region, size string, zones ...string) map[string]any {
+		z := make([]any, len(zones))
+		for i := range zones {
+			z[i] = zones[i]
+		}
+		return map[string]any{"spec": map[string]any{"forProvider": map[string]any{
+			"region": region,
+			"size":   size,
+			"zones":  z,
+		}}}
+	}
+
+	type args struct {
+		oldObj any
+		newObj any
+		paths  []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"Unchanged": {
+			reason: "Changing only mutable fields should be allowed.",
+			args: args{
+				oldObj: obj("us-east-1", "small"),
+				newObj: obj("us-east-1", "large"),
+				paths:  []string{"spec.forProvider.region"},
+			},
+		},
+		"Changed": {
+			reason: "Changing an immutable field should return an error naming it.",
+			args: args{
+				oldObj: obj("us-east-1", "small"),
+				newObj: obj("us-west-2", "small"),
+				paths:  []string{"spec.forProvider.region"},
+			},
+			want: errors.Errorf(errFmtImmutableFieldsChanged, "spec.forProvider.region"),
+		},
+		"NestedWildcard": {
+			reason: "Changing nested immutable fields should return an error naming each of them.",
+			args: args{
+				oldObj: obj("us-east-1", "small", "a", "b"),
+				newObj: obj("us-west-2", "small", "a", "c", "d"),
+				paths:  []string{"spec.forProvider.region", "spec.forProvider.zones[*]"},
+			},
+			want: errors.Errorf(errFmtImmutableFieldsChanged, "spec.forProvider.region, spec.forProvider.zones[1], spec.forProvider.zones[2]"),
+		},
+		"Unset": {
+			reason: "Unsetting an immutable field should return an error.",
+			args: args{
+				oldObj: obj("us-east-1", "small"),
+				newObj: map[string]any{"spec": map[string]any{}},
+				paths:  []string{"spec.forProvider.region"},
+			},
+			want: errors.Errorf(errFmtImmutableFieldsChanged, "spec.forProvider.region"),
+		},
+		"NotSet": {
+			reason: "An immutable field that is set in neither object shouldn't be considered changed.",
+			args: args{
+				oldObj: map[string]any{},
+				newObj: map[string]any{},
+				paths:  []string{"spec.forProvider.region", "spec.forProvider.zones[*]"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateImmutableFields(tc.args.oldObj, tc.args.newObj, tc.args.paths...)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateImmutableFields(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
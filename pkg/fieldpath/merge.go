@@ -18,6 +18,7 @@ package fieldpath
 
 import (
 	"reflect"
+	"sort"
 
 	"dario.cat/mergo"
 
@@ -26,9 +27,183 @@ import (
 )
 
 const (
-	errInvalidMerge = "failed to merge values"
+	errInvalidMerge         = "failed to merge values"
+	errFmtMergeTypeMismatch = "cannot merge field %q: destination is %s, but source is %s"
+	errFmtMergeConflict     = "cannot merge field %q: destination value %v conflicts with source value %v"
 )
 
+// A SliceMergeStrategy determines how Merge merges two slices.
+type SliceMergeStrategy string
+
+// Slice merge strategies.
+const (
+	// SliceReplace replaces the destination slice with the source slice. This
+	// is the default.
+	SliceReplace SliceMergeStrategy = "Replace"
+
+	// SliceAppend appends the elements of the source slice that aren't
+	// already in the destination slice to the destination slice.
+	SliceAppend SliceMergeStrategy = "Append"
+)
+
+// A MapMergeStrategy determines how Merge merges two maps.
+type MapMergeStrategy string
+
+// Map merge strategies.
+const (
+	// MapMerge recursively merges the fields of the source map into the
+	// destination map. This is the default.
+	MapMerge MapMergeStrategy = "Merge"
+
+	// MapReplace replaces the destination map with the source map.
+	MapReplace MapMergeStrategy = "Replace"
+)
+
+// MergeOptions configure how Merge merges two paved objects.
+type MergeOptions struct {
+	// Slices determines how slices are merged. Defaults to SliceReplace.
+	Slices SliceMergeStrategy
+
+	// Maps determines how maps are merged. Defaults to MapMerge.
+	Maps MapMergeStrategy
+
+	// OnlySetUnset causes Merge to only set fields that are unset in the
+	// destination, e.g. to late-initialize a managed resource's spec. Maps
+	// are always merged recursively, and values that are set in the
+	// destination are never replaced.
+	OnlySetUnset bool
+
+	// ErrorOnConflict causes Merge to return an error if the destination and
+	// source have different scalar values for the same field, rather than
+	// replacing the destination value.
+	ErrorOnConflict bool
+}
+
+// Merge the supplied src object into the supplied dst object according to the
+// supplied options. Fields that are unset or null in src are never merged. An
+// error is returned if a field is of a different type in dst and src, for
+// example if it is an object in dst but a string in src. dst is left unchanged
+// if an error is returned.
+func Merge(dst, src *Paved, opts MergeOptions) error {
+	merged, err := mergeValues(nil, dst.object, src.object, opts)
+	if err != nil {
+		return err
+	}
+	if merged == nil {
+		merged = map[string]any{}
+	}
+	dst.object = merged.(map[string]any) //nolint:forcetypeassert // Merging two objects always returns an object.
+	return nil
+}
+
+// mergeValues merges src into dst, which are found at the supplied path. It
+// returns the merged value without modifying dst or src.
+func mergeValues(path Segments, dst, src any, opts MergeOptions) (any, error) {
+	if src == nil {
+		return dst, nil
+	}
+	if dst == nil {
+		return deepCopyValue(src), nil
+	}
+	if dk, sk := jsonKind(dst), jsonKind(src); dk != sk {
+		return nil, errors.Errorf(errFmtMergeTypeMismatch, path.String(), dk, sk)
+	}
+
+	switch d := dst.(type) {
+	case map[string]any:
+		s := src.(map[string]any) //nolint:forcetypeassert // Kinds are checked above.
+		if opts.Maps == MapReplace && !opts.OnlySetUnset {
+			return deepCopyValue(s), nil
+		}
+		keys := make([]string, 0, len(s))
+		for k := range s {
+			keys = append(keys, k)
+		}
+		// Merge fields in a stable order so that errors are deterministic.
+		sort.Strings(keys)
+		out := make(map[string]any, len(d)+len(s))
+		for k, v := range d {
+			out[k] = v
+		}
+		for _, k := range keys {
+			v, err := mergeValues(append(path[:len(path):len(path)], Field(k)), d[k], s[k], opts)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = v
+		}
+		return out, nil
+	case []any:
+		if opts.OnlySetUnset {
+			return d, nil
+		}
+		s := src.([]any) //nolint:forcetypeassert // Kinds are checked above.
+		if opts.Slices != SliceAppend {
+			return deepCopyValue(s), nil
+		}
+		out := append([]any{}, d...)
+		for _, v := range s {
+			found := false
+			for _, e := range d {
+				if reflect.DeepEqual(e, v) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				out = append(out, deepCopyValue(v))
+			}
+		}
+		return out, nil
+	}
+
+	if opts.OnlySetUnset {
+		return dst, nil
+	}
+	if opts.ErrorOnConflict && !reflect.DeepEqual(dst, src) {
+		return nil, errors.Errorf(errFmtMergeConflict, path.String(), dst, src)
+	}
+	return src, nil
+}
+
+// jsonKind returns the kind of JSON value the supplied value represents.
+func jsonKind(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case int, int32, int64, float32, float64:
+		return "a number"
+	default:
+		return reflect.TypeOf(v).String()
+	}
+}
+
+// deepCopyValue returns a deep copy of the supplied JSON value.
+func deepCopyValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, e := range t {
+			out[k] = deepCopyValue(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, e := range t {
+			out[i] = deepCopyValue(e)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
 // MergeValue of the receiver p at the specified field path with the supplied
 // value according to supplied merge options.
 func (p *Paved) MergeValue(path string, value any, mo *xpv1.MergeOptions) error {
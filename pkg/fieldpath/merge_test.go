@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/json"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 )
 
@@ -211,3 +212,129 @@ func TestMergeValue(t *testing.T) {
 		})
 	}
 }
+
+func TestMerge(t *testing.T) {
+	pave := func(s string) *Paved {
+		obj := map[string]any{}
+		if err := json.Unmarshal([]byte(s), &obj); err != nil {
+			t.Fatalf("json.Unmarshal(%q): %v", s, err)
+		}
+		return Pave(obj)
+	}
+
+	type args struct {
+		dst  string
+		src  string
+		opts MergeOptions
+	}
+	type want struct {
+		dst string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ReplaceSlices": {
+			reason: "By default nested slices should be replaced.",
+			args: args{
+				dst: `{"spec":{"a":["1","2"],"b":"x"}}`,
+				src: `{"spec":{"a":["3"]}}`,
+			},
+			want: want{
+				dst: `{"spec":{"a":["3"],"b":"x"}}`,
+			},
+		},
+		"AppendSlices": {
+			reason: "Elements of nested source slices that aren't already in the destination slice should be appended.",
+			args: args{
+				dst:  `{"spec":{"a":["1","2"],"b":"x"}}`,
+				src:  `{"spec":{"a":["2","3"]}}`,
+				opts: MergeOptions{Slices: SliceAppend},
+			},
+			want: want{
+				dst: `{"spec":{"a":["1","2","3"],"b":"x"}}`,
+			},
+		},
+		"MergeMaps": {
+			reason: "By default nested maps should be merged recursively, with source values replacing destination values.",
+			args: args{
+				dst: `{"spec":{"m":{"a":"1","b":{"c":"2","d":"3"}}}}`,
+				src: `{"spec":{"m":{"b":{"d":"4"},"e":"5"}}}`,
+			},
+			want: want{
+				dst: `{"spec":{"m":{"a":"1","b":{"c":"2","d":"4"},"e":"5"}}}`,
+			},
+		},
+		"ReplaceMaps": {
+			reason: "Nested maps should be replaced by the source map.",
+			args: args{
+				dst:  `{"spec":{"m":{"a":"1","b":"2"}}}`,
+				src:  `{"spec":{"m":{"b":"3"}}}`,
+				opts: MergeOptions{Maps: MapReplace},
+			},
+			want: want{
+				dst: `{"spec":{"m":{"b":"3"}}}`,
+			},
+		},
+		"OnlySetUnset": {
+			reason: "Only fields that are unset in the destination should be set.",
+			args: args{
+				dst:  `{"spec":{"a":"set","l":["1"],"m":{"x":"1"}}}`,
+				src:  `{"spec":{"a":"other","b":"new","l":["2"],"m":{"x":"2","y":"3"}}}`,
+				opts: MergeOptions{OnlySetUnset: true, Maps: MapReplace, Slices: SliceAppend},
+			},
+			want: want{
+				dst: `{"spec":{"a":"set","b":"new","l":["1"],"m":{"x":"1","y":"3"}}}`,
+			},
+		},
+		"NullSource": {
+			reason: "Null source values should not be merged.",
+			args: args{
+				dst: `{"spec":{"a":"set"}}`,
+				src: `{"spec":{"a":null}}`,
+			},
+			want: want{
+				dst: `{"spec":{"a":"set"}}`,
+			},
+		},
+		"TypeMismatch": {
+			reason: "An error should be returned if a field is of a different type in the destination and source, and the destination should be unchanged.",
+			args: args{
+				dst: `{"spec":{"a":{"b":"c"},"d":"e"}}`,
+				src: `{"spec":{"a":"str","d":"f"}}`,
+			},
+			want: want{
+				dst: `{"spec":{"a":{"b":"c"},"d":"e"}}`,
+				err: errors.Errorf(errFmtMergeTypeMismatch, "spec.a", "an object", "a string"),
+			},
+		},
+		"Conflict": {
+			reason: "An error should be returned if a nested scalar field has different values and conflicts are errors.",
+			args: args{
+				dst:  `{"spec":{"m":{"a":"1"}}}`,
+				src:  `{"spec":{"m":{"a":"2"}}}`,
+				opts: MergeOptions{ErrorOnConflict: true},
+			},
+			want: want{
+				dst: `{"spec":{"m":{"a":"1"}}}`,
+				err: errors.Errorf(errFmtMergeConflict, "spec.m.a", "1", "2"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dst, src := pave(tc.args.dst), pave(tc.args.src)
+			err := Merge(dst, src, tc.args.opts)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nMerge(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(pave(tc.want.dst).UnstructuredContent(), dst.UnstructuredContent()); diff != "" {
+				t.Errorf("\n%s\nMerge(...): -want dst, +got dst:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
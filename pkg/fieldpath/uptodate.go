@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const (
+	errPaveObserved    = "cannot pave observed object"
+	errPaveDesired     = "cannot pave desired object"
+	errFmtIgnorePath   = "cannot ignore field path %q"
+	errNotJSONObject   = "object is not a JSON object"
+	errMarshalObject   = "cannot marshal object to JSON"
+	errUnmarshalObject = "cannot unmarshal object from JSON"
+)
+
+// serverManagedPaths are the field paths of fields that are set by the API
+// server, and are therefore always ignored by IsUpToDate.
+var serverManagedPaths = []string{
+	"status",
+	"metadata.uid",
+	"metadata.resourceVersion",
+	"metadata.generation",
+	"metadata.creationTimestamp",
+	"metadata.deletionTimestamp",
+	"metadata.deletionGracePeriodSeconds",
+	"metadata.managedFields",
+	"metadata.selfLink",
+}
+
+// IsUpToDate returns true if the observed object is up to date with the
+// desired object. Objects are compared as JSON, ignoring their status, fields
+// of their metadata that are set by the API server, and any fields at the
+// supplied ignore paths. Ignore paths may contain wildcards. IsUpToDate also
+// returns the sorted field paths at which the objects differ.
+func IsUpToDate(observed, desired any, ignorePaths ...string) (bool, []string, error) {
+	o, err := paveAny(observed)
+	if err != nil {
+		return false, nil, errors.Wrap(err, errPaveObserved)
+	}
+	d, err := paveAny(desired)
+	if err != nil {
+		return false, nil, errors.Wrap(err, errPaveDesired)
+	}
+
+	ignore := append(append([]string{}, serverManagedPaths...), ignorePaths...)
+	for _, p := range []*Paved{o, d} {
+		for _, path := range ignore {
+			if err := p.deleteAll(path); err != nil {
+				return false, nil, errors.Wrapf(err, errFmtIgnorePath, path)
+			}
+		}
+	}
+
+	diff := diffPaths(nil, o.UnstructuredContent(), d.UnstructuredContent())
+	return len(diff) == 0, diff, nil
+}
+
+// paveAny paves the JSON representation of the supplied value.
+func paveAny(v any) (*Paved, error) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalObject)
+	}
+	var obj any
+	if err := json.Unmarshal(j, &obj); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalObject)
+	}
+	if obj == nil {
+		return Pave(map[string]any{}), nil
+	}
+	m, ok := obj.(map[string]any)
+	if !ok {
+		return nil, errors.New(errNotJSONObject)
+	}
+	return Pave(m), nil
+}
+
+// deleteAll deletes the fields at the supplied path, which may contain
+// wildcards.
+func (p *Paved) deleteAll(path string) error {
+	paths, err := p.ExpandWildcards(path)
+	if err != nil {
+		return err
+	}
+	// Deleting an array element shifts the elements that follow it, so we
+	// delete from the last expanded path to the first.
+	for i := len(paths) - 1; i >= 0; i-- {
+		if err := p.DeleteField(paths[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffPaths returns the sorted field paths at which the two supplied values
+// differ, relative to the supplied path.
+func diffPaths(path Segments, a, b any) []string {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if aok && bok {
+		keys := make(map[string]bool, len(am)+len(bm))
+		for k := range am {
+			keys[k] = true
+		}
+		for k := range bm {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		var diff []string
+		for _, k := range sorted {
+			diff = append(diff, diffPaths(append(path[:len(path):len(path)], Field(k)), am[k], bm[k])...)
+		}
+		return diff
+	}
+
+	as, aok := a.([]any)
+	bs, bok := b.([]any)
+	if aok && bok {
+		var diff []string
+		for i := 0; i < len(as) || i < len(bs); i++ {
+			var ai, bi any
+			if i < len(as) {
+				ai = as[i]
+			}
+			if i < len(bs) {
+				bi = bs[i]
+			}
+			diff = append(diff, diffPaths(append(path[:len(path):len(path)], Segment{Type: SegmentIndex, Index: uint(i)}), ai, bi)...)
+		}
+		return diff
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return nil
+	}
+	return []string{path.String()}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		observed    any
+		desired     any
+		ignorePaths []string
+	}
+	type want struct {
+		upToDate bool
+		diff     []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Equal": {
+			reason: "Objects that differ only in server-managed fields and status should be up to date.",
+			args: args{
+				observed: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "cool", ResourceVersion: "42", UID: "cool-uid", Generation: 2},
+					Data:       map[string]string{"a": "b"},
+				},
+				desired: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "cool"},
+					Data:       map[string]string{"a": "b"},
+				},
+			},
+			want: want{upToDate: true},
+		},
+		"SingleDifferingField": {
+			reason: "Objects that differ in a single field should not be up to date, and should report the differing field.",
+			args: args{
+				observed: map[string]any{
+					"spec": map[string]any{
+						"forProvider": map[string]any{"region": "us-east-1", "size": 2},
+					},
+				},
+				desired: map[string]any{
+					"spec": map[string]any{
+						"forProvider": map[string]any{"region": "us-west-2", "size": 2},
+					},
+				},
+			},
+			want: want{diff: []string{"spec.forProvider.region"}},
+		},
+		"DifferingArrayElementsAndKeys": {
+			reason: "Differing array elements and keys containing periods should be reported using field path syntax.",
+			args: args{
+				observed: map[string]any{
+					"metadata": map[string]any{"labels": map[string]any{"example.org/cool": "yes"}},
+					"spec":     map[string]any{"tags": []any{"a", "b"}},
+				},
+				desired: map[string]any{
+					"metadata": map[string]any{"labels": map[string]any{"example.org/cool": "no"}},
+					"spec":     map[string]any{"tags": []any{"a", "c", "d"}},
+				},
+			},
+			want: want{diff: []string{"metadata.labels[example.org/cool]", "spec.tags[1]", "spec.tags[2]"}},
+		},
+		"IgnoredPaths": {
+			reason: "Fields at ignored paths, including wildcard paths, should not be compared.",
+			args: args{
+				observed: map[string]any{
+					"spec": map[string]any{
+						"forProvider": map[string]any{"region": "us-east-1"},
+						"rules":       []any{map[string]any{"id": "a", "port": 80}, map[string]any{"id": "b", "port": 443}},
+					},
+				},
+				desired: map[string]any{
+					"spec": map[string]any{
+						"forProvider": map[string]any{"region": "us-west-2"},
+						"rules":       []any{map[string]any{"port": 80}, map[string]any{"port": 443}},
+					},
+				},
+				ignorePaths: []string{"spec.forProvider.region", "spec.rules[*].id"},
+			},
+			want: want{upToDate: true},
+		},
+		"NotAnObject": {
+			reason: "Values that are not JSON objects should return an error.",
+			args: args{
+				observed: []string{"a"},
+				desired:  map[string]any{},
+			},
+			want: want{err: errors.Wrap(errors.New(errNotJSONObject), errPaveObserved)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, diff, err := IsUpToDate(tc.args.observed, tc.args.desired, tc.args.ignorePaths...)
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.diff, diff); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want diff, +got diff:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nIsUpToDate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,16 @@ limitations under the License.
 package meta
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"strconv"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -61,6 +67,54 @@ const (
 	// the resource will be filtered and thus no further reconcile requests
 	// will be queued for the resource.
 	AnnotationKeyReconciliationPaused = "crossplane.io/paused"
+
+	// AnnotationKeyReconciliationPausedUntil is the key in the annotations
+	// map of a resource that indicates that further reconciliations on the
+	// resource are paused until the specified time. Its value must be an
+	// RFC3339 timestamp.
+	AnnotationKeyReconciliationPausedUntil = "crossplane.io/paused-until"
+
+	// AnnotationKeyReconciliationPausedReason is the key in the annotations
+	// map of a resource that records why, and optionally by whom, its
+	// reconciliation was paused. Its value is free-form text.
+	AnnotationKeyReconciliationPausedReason = "crossplane.io/paused-reason"
+
+	// AnnotationKeyTerminalErrorGeneration is the key in the annotations map
+	// of a resource that indicates the generation of the resource that most
+	// recently failed terminally, i.e. failed in a way that retrying would not
+	// fix. Its value must be an integer.
+	AnnotationKeyTerminalErrorGeneration = "crossplane.io/terminal-error-generation"
+
+	// AnnotationKeyResolvedReferences is the key in the annotations map of a
+	// resource that records the generation at which its references were most
+	// recently resolved, and the generations of the resources it referenced
+	// at the time. Its value is opaque and should not be edited.
+	AnnotationKeyResolvedReferences = "crossplane.io/resolved-references"
+
+	// AnnotationKeyUnresolvedReferences is the key in the annotations map of a
+	// resource that records the resources it read when it most recently failed
+	// to resolve its references. It records no resources once its references
+	// are resolved. Its value is opaque and should not be edited.
+	AnnotationKeyUnresolvedReferences = "crossplane.io/unresolved-references"
+
+	// AnnotationKeyDeletionAttempt is the key in the annotations map of a
+	// resource that indicates the first time deletion of the external
+	// resource failed. Its value must be an RFC3339 timestamp.
+	AnnotationKeyDeletionAttempt = "crossplane.io/deletion-attempt-time"
+
+	// AnnotationKeySpecHash is the key in the annotations map of a resource
+	// that records a hash of its spec, as computed by SpecHash. Its value is
+	// opaque and should not be edited.
+	AnnotationKeySpecHash = "crossplane.io/spec-hash"
+)
+
+// Error strings.
+const (
+	errConvertToUnstructured = "cannot convert object to unstructured data"
+	errMarshalSpec           = "cannot marshal spec"
+
+	errFmtExternalNameTooLong = "external name %q is %d characters long, which exceeds the maximum of %d"
+	errFmtExternalNamePattern = "external name %q does not match pattern %q"
 )
 
 // ReferenceTo returns an object reference to the supplied object, presumed to
@@ -263,6 +317,29 @@ func SetExternalName(o metav1.Object, name string) {
 	AddAnnotations(o, map[string]string{AnnotationKeyExternalName: name})
 }
 
+// ExternalNameRules constrain the external names a provider accepts.
+type ExternalNameRules struct {
+	// MaxLength is the maximum length of an external name, in characters.
+	// Zero means there is no maximum length.
+	MaxLength int
+
+	// Pattern is a regular expression that external names must match. A nil
+	// Pattern matches all external names.
+	Pattern *regexp.Regexp
+}
+
+// ValidateExternalName returns an error if the supplied external name does not
+// satisfy the supplied rules.
+func ValidateExternalName(name string, rules ExternalNameRules) error {
+	if l := len([]rune(name)); rules.MaxLength > 0 && l > rules.MaxLength {
+		return errors.Errorf(errFmtExternalNameTooLong, name, l, rules.MaxLength)
+	}
+	if rules.Pattern != nil && !rules.Pattern.MatchString(name) {
+		return errors.Errorf(errFmtExternalNamePattern, name, rules.Pattern.String())
+	}
+	return nil
+}
+
 // GetExternalCreatePending returns the time at which the external resource
 // was most recently pending creation.
 func GetExternalCreatePending(o metav1.Object) time.Time {
@@ -280,6 +357,53 @@ func SetExternalCreatePending(o metav1.Object, t time.Time) {
 	AddAnnotations(o, map[string]string{AnnotationKeyExternalCreatePending: t.Format(time.RFC3339)})
 }
 
+// GetDeletionAttemptTime returns the time at which deletion of the external
+// resource first failed. It returns the zero time if deletion has not failed.
+func GetDeletionAttemptTime(o metav1.Object) time.Time {
+	a := o.GetAnnotations()[AnnotationKeyDeletionAttempt]
+	t, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// SetDeletionAttemptTime sets the time at which deletion of the external
+// resource first failed to the supplied time.
+func SetDeletionAttemptTime(o metav1.Object, t time.Time) {
+	AddAnnotations(o, map[string]string{AnnotationKeyDeletionAttempt: t.Format(time.RFC3339)})
+}
+
+// SpecHash returns a deterministic hash of the spec of the supplied object.
+// Specs that are semantically equal hash equally; the order of map keys does
+// not affect the hash. An object without a spec hashes as if its spec were
+// null.
+func SpecHash(o runtime.Object) (string, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return "", errors.Wrap(err, errConvertToUnstructured)
+	}
+	// JSON encoding of maps sorts their keys, so the encoded spec is canonical.
+	j, err := json.Marshal(u["spec"])
+	if err != nil {
+		return "", errors.Wrap(err, errMarshalSpec)
+	}
+	h := sha256.Sum256(j)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// GetSpecHashAnnotation returns the spec hash recorded in the annotations of
+// the supplied object, if any.
+func GetSpecHashAnnotation(o metav1.Object) string {
+	return o.GetAnnotations()[AnnotationKeySpecHash]
+}
+
+// SetSpecHashAnnotation records the supplied spec hash in the annotations of
+// the supplied object.
+func SetSpecHashAnnotation(o metav1.Object, hash string) {
+	AddAnnotations(o, map[string]string{AnnotationKeySpecHash: hash})
+}
+
 // GetExternalCreateSucceeded returns the time at which the external resource
 // was most recently created.
 func GetExternalCreateSucceeded(o metav1.Object) time.Time {
@@ -340,11 +464,43 @@ func ExternalCreateIncomplete(o metav1.Object) bool {
 // resource that corresponds to the supplied managed resource succeeded within
 // the supplied duration.
 func ExternalCreateSucceededDuring(o metav1.Object, d time.Duration) bool {
+	return ExternalCreateSucceededDuringAt(o, d, time.Now())
+}
+
+// ExternalCreateSucceededDuringAt returns true if creation of the external
+// resource that corresponds to the supplied managed resource succeeded within
+// the supplied duration before the supplied time.
+func ExternalCreateSucceededDuringAt(o metav1.Object, d time.Duration, now time.Time) bool {
 	t := GetExternalCreateSucceeded(o)
 	if t.IsZero() {
 		return false
 	}
-	return time.Since(t) < d
+	return now.Sub(t) < d
+}
+
+// GetTerminalErrorGeneration returns the generation of the resource that most
+// recently failed terminally. It returns zero if the resource has not failed
+// terminally.
+func GetTerminalErrorGeneration(o metav1.Object) int64 {
+	g, err := strconv.ParseInt(o.GetAnnotations()[AnnotationKeyTerminalErrorGeneration], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return g
+}
+
+// SetTerminalErrorGeneration sets the generation of the resource that most
+// recently failed terminally.
+func SetTerminalErrorGeneration(o metav1.Object, generation int64) {
+	AddAnnotations(o, map[string]string{AnnotationKeyTerminalErrorGeneration: strconv.FormatInt(generation, 10)})
+}
+
+// TerminalErrorAtCurrentGeneration returns true if the resource failed
+// terminally at its current generation, i.e. if its spec has not changed since
+// it failed terminally.
+func TerminalErrorAtCurrentGeneration(o metav1.Object) bool {
+	g := GetTerminalErrorGeneration(o)
+	return g != 0 && g == o.GetGeneration()
 }
 
 // IsPaused returns true if the object has the AnnotationKeyReconciliationPaused
@@ -352,3 +508,38 @@ func ExternalCreateSucceededDuring(o metav1.Object, d time.Duration) bool {
 func IsPaused(o metav1.Object) bool {
 	return o.GetAnnotations()[AnnotationKeyReconciliationPaused] == "true"
 }
+
+// GetPausedUntil returns the time until which reconciliation of the object is
+// paused, per its AnnotationKeyReconciliationPausedUntil annotation. It returns
+// the zero time if the annotation is not set or is not a valid RFC3339
+// timestamp.
+func GetPausedUntil(o metav1.Object) time.Time {
+	t, err := time.Parse(time.RFC3339, o.GetAnnotations()[AnnotationKeyReconciliationPausedUntil])
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// SetPausedReason sets the reason reconciliation of the object is paused, by
+// setting its AnnotationKeyReconciliationPausedReason annotation. The reason
+// should explain why, and optionally by whom, reconciliation was paused.
+func SetPausedReason(o metav1.Object, reason string) {
+	AddAnnotations(o, map[string]string{AnnotationKeyReconciliationPausedReason: reason})
+}
+
+// GetPausedReason returns the reason reconciliation of the object is paused,
+// per its AnnotationKeyReconciliationPausedReason annotation. It returns an
+// empty string if the annotation is not set.
+func GetPausedReason(o metav1.Object) string {
+	return o.GetAnnotations()[AnnotationKeyReconciliationPausedReason]
+}
+
+// IsPausedAt returns true if the object should be treated as paused at the
+// supplied time, either because it has the AnnotationKeyReconciliationPaused
+// annotation set to `true`, or because its
+// AnnotationKeyReconciliationPausedUntil annotation is after the supplied
+// time.
+func IsPausedAt(o metav1.Object, now time.Time) bool {
+	return IsPaused(o) || now.Before(GetPausedUntil(o))
+}
@@ -17,12 +17,14 @@ limitations under the License.
 package meta
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -954,6 +956,58 @@ func TestSetExternalCreatePending(t *testing.T) {
 	}
 }
 
+func TestGetDeletionAttemptTime(t *testing.T) {
+	now := time.Now().Round(time.Second)
+
+	cases := map[string]struct {
+		o    metav1.Object
+		want time.Time
+	}{
+		"DeletionAttemptExists": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyDeletionAttempt: now.Format(time.RFC3339)}}},
+			want: now,
+		},
+		"NoDeletionAttempt": {
+			o:    &corev1.Pod{},
+			want: time.Time{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetDeletionAttemptTime(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetDeletionAttemptTime(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetDeletionAttemptTime(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]struct {
+		o    metav1.Object
+		t    time.Time
+		want metav1.Object
+	}{
+		"SetsTheCorrectKey": {
+			o:    &corev1.Pod{},
+			t:    now,
+			want: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyDeletionAttempt: now.Format(time.RFC3339)}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetDeletionAttemptTime(tc.o, tc.t)
+			if diff := cmp.Diff(tc.want, tc.o); diff != "" {
+				t.Errorf("SetDeletionAttemptTime(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetExternalCreateSucceeded(t *testing.T) {
 	now := time.Now().Round(time.Second)
 
@@ -1099,6 +1153,17 @@ func TestExternalCreateSucceededDuring(t *testing.T) {
 			},
 			want: true,
 		},
+		"NoGracePeriod": {
+			args: args{
+				o: func() metav1.Object {
+					o := &corev1.Pod{}
+					SetExternalCreateSucceeded(o, time.Now())
+					return o
+				}(),
+				d: 0,
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -1111,6 +1176,50 @@ func TestExternalCreateSucceededDuring(t *testing.T) {
 	}
 }
 
+func TestExternalCreateSucceededDuringAt(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	succeeded := func(t time.Time) metav1.Object {
+		o := &corev1.Pod{}
+		SetExternalCreateSucceeded(o, t)
+		return o
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      metav1.Object
+		d      time.Duration
+		want   bool
+	}{
+		"NotYetSuccessfullyCreated": {
+			reason: "An external resource that hasn't been created shouldn't have succeeded during any duration.",
+			o:      &corev1.Pod{},
+			d:      1 * time.Minute,
+			want:   false,
+		},
+		"SuccessfullyCreatedTooLongAgo": {
+			reason: "An external resource created longer than the duration before the supplied time shouldn't have succeeded during it.",
+			o:      succeeded(now.Add(-1 * time.Minute)),
+			d:      1 * time.Minute,
+			want:   false,
+		},
+		"SuccessfullyCreatedWithinDuration": {
+			reason: "An external resource created less than the duration before the supplied time should have succeeded during it.",
+			o:      succeeded(now.Add(-59 * time.Second)),
+			d:      1 * time.Minute,
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ExternalCreateSucceededDuringAt(tc.o, tc.d, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nExternalCreateSucceededDuringAt(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestExternalCreateIncomplete(t *testing.T) {
 	now := time.Now().Format(time.RFC3339)
 	earlier := time.Now().Add(-1 * time.Second).Format(time.RFC3339)
@@ -1167,6 +1276,38 @@ func TestExternalCreateIncomplete(t *testing.T) {
 			}}},
 			want: true,
 		},
+		"CreateCompletedNeverPending": {
+			reason: "If Create succeeded or failed but was never pending it can't be incomplete.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyExternalCreateSucceeded: earlier,
+				AnnotationKeyExternalCreateFailed:    now,
+			}}},
+			want: false,
+		},
+		"CreateCompletedWithinSameSecond": {
+			reason: "Annotations only have second precision, so if Create succeeded within the second it was pending it's complete.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyExternalCreatePending:   now,
+				AnnotationKeyExternalCreateSucceeded: now,
+			}}},
+			want: false,
+		},
+		"PendingSinceSuccessAndFailure": {
+			reason: "If Create is pending and both success and failure are older, it's incomplete.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyExternalCreateSucceeded: evenEarlier,
+				AnnotationKeyExternalCreateFailed:    earlier,
+				AnnotationKeyExternalCreatePending:   now,
+			}}},
+			want: true,
+		},
+		"InvalidPending": {
+			reason: "An unparseable pending annotation should be treated as though Create was never pending.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyExternalCreatePending: "not-a-time",
+			}}},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -1218,3 +1359,288 @@ func TestIsPaused(t *testing.T) {
 		})
 	}
 }
+
+func TestPausedReason(t *testing.T) {
+	cases := map[string]struct {
+		o    metav1.Object
+		set  string
+		want string
+	}{
+		"NoReason": {
+			o:    &corev1.Pod{},
+			want: "",
+		},
+		"SetReason": {
+			o:    &corev1.Pod{},
+			set:  "Database migration in progress (alice)",
+			want: "Database migration in progress (alice)",
+		},
+		"ReplaceReason": {
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyReconciliationPausedReason: "old",
+			}}},
+			set:  "new",
+			want: "new",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.set != "" {
+				SetPausedReason(tc.o, tc.set)
+			}
+			if diff := cmp.Diff(tc.want, GetPausedReason(tc.o)); diff != "" {
+				t.Errorf("GetPausedReason(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPausedAt(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]struct {
+		reason string
+		o      metav1.Object
+		want   bool
+	}{
+		"AlwaysPaused": {
+			reason: "An object with the pause annotation set to true should be paused.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyReconciliationPaused: "true",
+			}}},
+			want: true,
+		},
+		"PausedUntilFuture": {
+			reason: "An object paused until a future time should be paused.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyReconciliationPausedUntil: now.Add(time.Hour).Format(time.RFC3339),
+			}}},
+			want: true,
+		},
+		"PausedUntilPast": {
+			reason: "An object paused until a past time should not be paused.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyReconciliationPausedUntil: now.Add(-time.Hour).Format(time.RFC3339),
+			}}},
+			want: false,
+		},
+		"PausedUntilInvalid": {
+			reason: "An object with an invalid paused until timestamp should not be paused.",
+			o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				AnnotationKeyReconciliationPausedUntil: "tomorrow",
+			}}},
+			want: false,
+		},
+		"Unpaused": {
+			reason: "An object with no pause annotations should not be paused.",
+			o:      &corev1.Pod{},
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPausedAt(tc.o, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsPausedAt(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetTerminalErrorGeneration(t *testing.T) {
+	cases := map[string]struct {
+		o    metav1.Object
+		g    int64
+		want metav1.Object
+	}{
+		"SetsTheCorrectKey": {
+			o:    &corev1.Pod{},
+			g:    3,
+			want: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyTerminalErrorGeneration: "3"}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetTerminalErrorGeneration(tc.o, tc.g)
+			if diff := cmp.Diff(tc.want, tc.o); diff != "" {
+				t.Errorf("SetTerminalErrorGeneration(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTerminalErrorAtCurrentGeneration(t *testing.T) {
+	cases := map[string]struct {
+		o    metav1.Object
+		want bool
+	}{
+		"NoAnnotation": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Generation: 3}},
+			want: false,
+		},
+		"InvalidAnnotation": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Generation: 3, Annotations: map[string]string{AnnotationKeyTerminalErrorGeneration: "three"}}},
+			want: false,
+		},
+		"CurrentGeneration": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Generation: 3, Annotations: map[string]string{AnnotationKeyTerminalErrorGeneration: "3"}}},
+			want: true,
+		},
+		"PreviousGeneration": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Generation: 4, Annotations: map[string]string{AnnotationKeyTerminalErrorGeneration: "3"}}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TerminalErrorAtCurrentGeneration(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TerminalErrorAtCurrentGeneration(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateExternalName(t *testing.T) {
+	rules := ExternalNameRules{
+		MaxLength: 8,
+		Pattern:   regexp.MustCompile(`^[a-z][a-z0-9-]*$`),
+	}
+
+	type args struct {
+		name  string
+		rules ExternalNameRules
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"Valid": {
+			reason: "An external name that satisfies all rules should be valid.",
+			args:   args{name: "cool-db", rules: rules},
+		},
+		"TooLong": {
+			reason: "An external name longer than the maximum length should be invalid.",
+			args:   args{name: "cool-database", rules: rules},
+			want:   errors.Errorf(errFmtExternalNameTooLong, "cool-database", 13, 8),
+		},
+		"PatternViolated": {
+			reason: "An external name that doesn't match the pattern should be invalid.",
+			args:   args{name: "Cool_DB", rules: rules},
+			want:   errors.Errorf(errFmtExternalNamePattern, "Cool_DB", `^[a-z][a-z0-9-]*$`),
+		},
+		"NoRules": {
+			reason: "Any external name should be valid when there are no rules.",
+			args:   args{name: "Cool_Database"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateExternalName(tc.args.name, tc.args.rules)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateExternalName(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSpecHash(t *testing.T) {
+	obj := func(metadata, spec map[string]any) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]any{"metadata": metadata}}
+		if spec != nil {
+			u.Object["spec"] = spec
+		}
+		return u
+	}
+
+	type args struct {
+		a *unstructured.Unstructured
+		b *unstructured.Unstructured
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"SameSpec": {
+			reason: "Identical specs should hash equally.",
+			args: args{
+				a: obj(nil, map[string]any{"size": "large"}),
+				b: obj(nil, map[string]any{"size": "large"}),
+			},
+			want: true,
+		},
+		"DifferentKeyOrder": {
+			reason: "Semantically equal specs should hash equally regardless of map key order.",
+			args: args{
+				a: obj(nil, map[string]any{"size": "large", "region": "us-east-1", "tags": map[string]any{"a": "1", "b": "2"}}),
+				b: obj(nil, map[string]any{"tags": map[string]any{"b": "2", "a": "1"}, "region": "us-east-1", "size": "large"}),
+			},
+			want: true,
+		},
+		"DifferentMetadata": {
+			reason: "Changes outside the spec should not alter the hash.",
+			args: args{
+				a: obj(map[string]any{"name": "cool"}, map[string]any{"size": "large"}),
+				b: obj(map[string]any{"name": "cooler"}, map[string]any{"size": "large"}),
+			},
+			want: true,
+		},
+		"DifferentValue": {
+			reason: "Changing a spec value should alter the hash.",
+			args: args{
+				a: obj(nil, map[string]any{"size": "large"}),
+				b: obj(nil, map[string]any{"size": "small"}),
+			},
+			want: false,
+		},
+		"AddedField": {
+			reason: "Adding a spec field should alter the hash.",
+			args: args{
+				a: obj(nil, map[string]any{"size": "large"}),
+				b: obj(nil, map[string]any{"size": "large", "region": "us-east-1"}),
+			},
+			want: false,
+		},
+		"NoSpec": {
+			reason: "Objects without a spec should hash equally.",
+			args: args{
+				a: obj(map[string]any{"name": "cool"}, nil),
+				b: obj(map[string]any{"name": "cooler"}, nil),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, err := SpecHash(tc.args.a)
+			if err != nil {
+				t.Fatalf("\n%s\nSpecHash(a): unexpected error: %v", tc.reason, err)
+			}
+			b, err := SpecHash(tc.args.b)
+			if err != nil {
+				t.Fatalf("\n%s\nSpecHash(b): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, a == b); diff != "" {
+				t.Errorf("\n%s\nSpecHash(a) == SpecHash(b): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSpecHashAnnotation(t *testing.T) {
+	o := &corev1.Pod{}
+	if got := GetSpecHashAnnotation(o); got != "" {
+		t.Errorf("GetSpecHashAnnotation(...): want \"\", got %q", got)
+	}
+	SetSpecHashAnnotation(o, "cool")
+	if diff := cmp.Diff("cool", GetSpecHashAnnotation(o)); diff != "" {
+		t.Errorf("GetSpecHashAnnotation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -19,7 +19,33 @@ package password
 
 import (
 	"crypto/rand"
+	"math"
 	"math/big"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// A CharacterClass is a class of characters, such as lowercase letters.
+type CharacterClass string
+
+// Character classes.
+const (
+	Lowercase CharacterClass = "abcdefghijklmnopqrstuvwxyz"
+	Uppercase CharacterClass = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Digits    CharacterClass = "0123456789"
+	Symbols   CharacterClass = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+)
+
+// Ambiguous characters are easily confused with one another when read.
+const Ambiguous = "0O1lI|"
+
+const (
+	errInvalidLength     = "password length must be greater than zero"
+	errNoCharacters      = "no characters are allowed"
+	errTooManyRequired   = "password length is less than the number of required character classes"
+	errFmtNoneOfRequired = "none of the required character class %q is allowed"
+	errFmtLowEntropy     = "password entropy of %.1f bits is less than the required %.1f bits"
 )
 
 // Settings for password generation.
@@ -29,6 +55,18 @@ type Settings struct {
 
 	// Length of generated passwords.
 	Length int
+
+	// RequiredClasses of characters. Generated passwords will contain at
+	// least one allowed character from each required class.
+	RequiredClasses []CharacterClass
+
+	// ExcludedCharacters will never appear in generated passwords, even if
+	// they're in the CharacterSet. Use Ambiguous to exclude characters that
+	// are easily confused with one another.
+	ExcludedCharacters string
+
+	// MinEntropy is the minimum entropy, in bits, of generated passwords.
+	MinEntropy float64
 }
 
 // Default password generation settings.
@@ -45,15 +83,106 @@ func Generate() (string, error) {
 	return Default.Generate()
 }
 
-// Generate a password.
+// Entropy returns the entropy, in bits, of passwords generated using these
+// settings. It assumes each character is chosen uniformly from the allowed
+// characters, and thus slightly overestimates the entropy of passwords that
+// must contain required classes of characters.
+func (s Settings) Entropy() float64 {
+	n := len(s.allowed())
+	if n == 0 {
+		return 0
+	}
+	return float64(s.Length) * math.Log2(float64(n))
+}
+
+// Validate returns an error if passwords can't be generated using these
+// settings.
+func (s Settings) Validate() error {
+	if s.Length <= 0 {
+		return errors.New(errInvalidLength)
+	}
+	allowed := s.allowed()
+	if len(allowed) == 0 {
+		return errors.New(errNoCharacters)
+	}
+	if len(s.RequiredClasses) > s.Length {
+		return errors.New(errTooManyRequired)
+	}
+	for _, c := range s.RequiredClasses {
+		if len(intersect(allowed, string(c))) == 0 {
+			return errors.Errorf(errFmtNoneOfRequired, c)
+		}
+	}
+	if e := s.Entropy(); e < s.MinEntropy {
+		return errors.Errorf(errFmtLowEntropy, e, s.MinEntropy)
+	}
+	return nil
+}
+
+// Generate a password. It returns an error if the settings are invalid.
 func (s Settings) Generate() (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+	allowed := s.allowed()
+
 	pw := make([]byte, s.Length)
 	for i := range s.Length {
-		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(s.CharacterSet))))
+		// The first characters are chosen from each required class. The
+		// password is shuffled once it's generated.
+		set := allowed
+		if i < len(s.RequiredClasses) {
+			set = intersect(allowed, string(s.RequiredClasses[i]))
+		}
+		n, err := randInt(len(set))
+		if err != nil {
+			return "", err
+		}
+		pw[i] = set[n]
+	}
+
+	// Shuffle the password so that required characters can appear anywhere.
+	for i := len(pw) - 1; i > 0; i-- {
+		j, err := randInt(i + 1)
 		if err != nil {
 			return "", err
 		}
-		pw[i] = s.CharacterSet[n.Int64()]
+		pw[i], pw[j] = pw[j], pw[i]
 	}
+
 	return string(pw), nil
 }
+
+// allowed returns the unique allowed characters.
+func (s Settings) allowed() []byte {
+	out := make([]byte, 0, len(s.CharacterSet))
+	seen := map[byte]bool{}
+	for i := range len(s.CharacterSet) {
+		c := s.CharacterSet[i]
+		if seen[c] || strings.IndexByte(s.ExcludedCharacters, c) >= 0 {
+			continue
+		}
+		seen[c] = true
+		out = append(out, c)
+	}
+	return out
+}
+
+// intersect returns the supplied characters that are in the supplied set.
+func intersect(chars []byte, set string) []byte {
+	out := make([]byte, 0, len(chars))
+	for _, c := range chars {
+		if strings.IndexByte(set, c) >= 0 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func randInt(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
@@ -1,9 +1,13 @@
 package password
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 )
 
 func TestGenerate(t *testing.T) {
@@ -18,3 +22,99 @@ func TestGenerate(t *testing.T) {
 		t.Errorf("Generate: %s\n", err)
 	}
 }
+
+func TestSettingsGenerate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		s      Settings
+	}{
+		"RequiredClasses": {
+			reason: "Generated passwords should contain a character from each required class.",
+			s: Settings{
+				CharacterSet:    string(Lowercase + Uppercase + Digits + Symbols),
+				Length:          4,
+				RequiredClasses: []CharacterClass{Lowercase, Uppercase, Digits, Symbols},
+			},
+		},
+		"ExcludedCharacters": {
+			reason: "Generated passwords should not contain excluded characters.",
+			s: Settings{
+				CharacterSet:       string(Digits),
+				Length:             32,
+				RequiredClasses:    []CharacterClass{Digits},
+				ExcludedCharacters: Ambiguous,
+				MinEntropy:         64,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Generation is random, so we generate several passwords.
+			for range 20 {
+				pw, err := tc.s.Generate()
+				if err != nil {
+					t.Fatalf("\n%s\nGenerate(): %s", tc.reason, err)
+				}
+				if diff := cmp.Diff(tc.s.Length, len(pw)); diff != "" {
+					t.Errorf("\n%s\nGenerate(): -want length, +got length:\n%s", tc.reason, diff)
+				}
+				for _, c := range tc.s.RequiredClasses {
+					if !strings.ContainsAny(pw, string(c)) {
+						t.Errorf("\n%s\nGenerate(): %q contains no characters from required class %q", tc.reason, pw, c)
+					}
+				}
+				if strings.ContainsAny(pw, tc.s.ExcludedCharacters) {
+					t.Errorf("\n%s\nGenerate(): %q contains excluded characters %q", tc.reason, pw, tc.s.ExcludedCharacters)
+				}
+			}
+		})
+	}
+}
+
+func TestSettingsValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		s      Settings
+		want   error
+	}{
+		"Valid": {
+			reason: "Satisfiable settings should be valid.",
+			s:      Settings{CharacterSet: string(Lowercase + Digits), Length: 16, RequiredClasses: []CharacterClass{Lowercase, Digits}, MinEntropy: 64},
+		},
+		"ZeroLength": {
+			reason: "Passwords must have a length.",
+			s:      Settings{CharacterSet: string(Lowercase)},
+			want:   errors.New(errInvalidLength),
+		},
+		"AllExcluded": {
+			reason: "At least one character must be allowed.",
+			s:      Settings{CharacterSet: "01", Length: 8, ExcludedCharacters: Ambiguous},
+			want:   errors.New(errNoCharacters),
+		},
+		"TooManyRequired": {
+			reason: "The length must be enough to include each required class.",
+			s:      Settings{CharacterSet: string(Lowercase + Uppercase), Length: 1, RequiredClasses: []CharacterClass{Lowercase, Uppercase}},
+			want:   errors.New(errTooManyRequired),
+		},
+		"RequiredNotAllowed": {
+			reason: "Required classes must include at least one allowed character.",
+			s:      Settings{CharacterSet: string(Lowercase), Length: 8, RequiredClasses: []CharacterClass{Symbols}},
+			want:   errors.Errorf(errFmtNoneOfRequired, Symbols),
+		},
+		"LowEntropy": {
+			reason: "Settings that can't meet the minimum entropy should be invalid.",
+			s:      Settings{CharacterSet: "ab", Length: 8, MinEntropy: 64},
+			want:   errors.Errorf(errFmtLowEntropy, 8.0, 64.0),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.s.Validate()
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
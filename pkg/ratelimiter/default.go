@@ -36,11 +36,57 @@ func NewGlobal(rps int) *BucketRateLimiter {
 // ControllerRateLimiter to work with [sigs.k8s.io/controller-runtime/pkg/controller.Options].
 type ControllerRateLimiter = workqueue.TypedRateLimiter[reconcile.Request]
 
+const (
+	defaultMaxRate   = 10
+	defaultBaseDelay = 1 * time.Second
+	defaultMaxDelay  = 60 * time.Second
+)
+
 // NewController returns a rate limiter that takes the maximum delay between the
 // passed rate limiter and a per-item exponential backoff limiter. The
 // exponential backoff limiter has a base delay of 1s and a maximum of 60s.
 func NewController() ControllerRateLimiter {
-	return workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](1*time.Second, 60*time.Second)
+	return workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](defaultBaseDelay, defaultMaxDelay)
+}
+
+// A Config configures a rate limiter returned by NewCombined.
+type Config struct {
+	// MaxRate is the maximum average number of items per second allowed
+	// across all items. Bursts of up to MaxRate * 10 items are allowed.
+	// Defaults to 10 if not positive.
+	MaxRate int
+
+	// BaseDelay is the delay after an item's first failure. The delay
+	// doubles with each subsequent failure. Defaults to 1s if not positive.
+	BaseDelay time.Duration
+
+	// MaxDelay is the maximum delay after an item's failure. Defaults to 60s
+	// if not positive.
+	MaxDelay time.Duration
+}
+
+// NewCombined returns a rate limiter that takes the maximum delay between a
+// per-item exponential backoff limiter and an overall token bucket limiter,
+// configured by the supplied Config. It's suitable for use as the rate limiter
+// of a provider's controllers, for example:
+//
+//	ratelimiter.NewCombined[reconcile.Request](ratelimiter.Config{MaxRate: 10, BaseDelay: time.Second, MaxDelay: time.Minute})
+func NewCombined[T comparable](c Config) workqueue.TypedRateLimiter[T] {
+	// A zero rate limiter would never admit an item, and a zero base delay
+	// would disable backoff, so we default any unset values.
+	if c.MaxRate <= 0 {
+		c.MaxRate = defaultMaxRate
+	}
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = defaultBaseDelay
+	}
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = defaultMaxDelay
+	}
+	return workqueue.NewTypedMaxOfRateLimiter[T](
+		workqueue.NewTypedItemExponentialFailureRateLimiter[T](c.BaseDelay, c.MaxDelay),
+		&workqueue.TypedBucketRateLimiter[T]{Limiter: rate.NewLimiter(rate.Limit(c.MaxRate), c.MaxRate*10)},
+	)
 }
 
 // LimitRESTConfig returns a copy of the supplied REST config with rate limits
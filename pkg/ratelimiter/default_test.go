@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimiter
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewCombined(t *testing.T) {
+	c := Config{MaxRate: 1, BaseDelay: time.Millisecond, MaxDelay: 8 * time.Millisecond}
+
+	t.Run("PerItemBackoff", func(t *testing.T) {
+		rl := NewCombined[string](c)
+
+		got := make([]time.Duration, 6)
+		for i := range got {
+			got[i] = rl.When("cool")
+		}
+		want := []time.Duration{
+			1 * time.Millisecond,
+			2 * time.Millisecond,
+			4 * time.Millisecond,
+			8 * time.Millisecond,
+			8 * time.Millisecond,
+			8 * time.Millisecond,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("\nThe per-item delay should grow exponentially up to the maximum delay.\nrl.When(...): -want, +got:\n%s", diff)
+		}
+
+		rl.Forget("cool")
+		if got := rl.When("cool"); got != c.BaseDelay {
+			t.Errorf("\nThe per-item delay should reset when an item is forgotten.\nrl.When(...): want %s, got %s", c.BaseDelay, got)
+		}
+	})
+
+	t.Run("OverallRate", func(t *testing.T) {
+		rl := NewCombined[string](c)
+
+		// Distinct items each get the base delay until the bucket's burst is
+		// exhausted.
+		burst := c.MaxRate * 10
+		for i := range burst {
+			if got := rl.When(strconv.Itoa(i)); got != c.BaseDelay {
+				t.Fatalf("\nItems within the allowed burst should only be delayed by the per-item backoff.\nrl.When(%d): want %s, got %s", i, c.BaseDelay, got)
+			}
+		}
+
+		// The bucket allows one item per second once its burst is exhausted.
+		if got := rl.When("limited"); got <= c.MaxDelay {
+			t.Errorf("\nItems exceeding the allowed burst should be delayed by the overall rate limit.\nrl.When(...): want more than %s, got %s", c.MaxDelay, got)
+		}
+	})
+	t.Run("ZeroConfig", func(t *testing.T) {
+		rl := NewCombined[string](Config{})
+
+		got := make([]time.Duration, 3)
+		for i := range got {
+			got[i] = rl.When("cool")
+		}
+		want := []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("\nA zero Config should default to exponential per-item backoff starting at 1s.\nrl.When(...): -want, +got:\n%s", diff)
+		}
+
+		// Distinct items should not be blocked by the overall rate limit.
+		for i := range defaultMaxRate * 10 {
+			if got := rl.When(strconv.Itoa(i)); got != defaultBaseDelay {
+				t.Fatalf("\nA zero Config should default to a usable overall rate limit.\nrl.When(%d): want %s, got %s", i, defaultBaseDelay, got)
+			}
+		}
+	})
+}
@@ -25,12 +25,15 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -45,6 +48,9 @@ const (
 // Error strings.
 const (
 	errCreateOrUpdateSecret      = "cannot create or update connection secret"
+	errGetSecret                 = "cannot get connection secret"
+	errOrphanSecret              = "cannot remove owner references from connection secret"
+	errDeleteSecret              = "cannot delete connection secret"
 	errUpdateManaged             = "cannot update managed resource"
 	errPatchManaged              = "cannot patch the managed resource via server-side apply"
 	errMarshalExisting           = "cannot marshal the existing object into JSON"
@@ -53,63 +59,257 @@ const (
 	errUpdateManagedStatus       = "cannot update managed resource status"
 	errResolveReferences         = "cannot resolve references"
 	errUpdateCriticalAnnotations = "cannot update critical annotations"
+	errPatchCriticalAnnotations  = "cannot patch critical annotations"
+	errMarshalAnnotations        = "cannot marshal annotations patch"
+	errGetProviderConfig         = "cannot get provider config"
+	errTransformExternalName     = "cannot transform name into external name"
+	errMarshalResolvedRefs       = "cannot marshal resolved references"
+	errCyclicReference           = "cannot fully resolve references: the managed resource and a resource it references reference each other"
 )
 
 // NameAsExternalName writes the name of the managed resource to
 // the external name annotation field in order to be used as name of
-// the external resource in provider.
-type NameAsExternalName struct{ client client.Client }
+// the external resource in provider. Managed resources that satisfy
+// resource.ExternalNameAccessor have their external name written using
+// that interface instead.
+type NameAsExternalName struct {
+	client    client.Client
+	transform ExternalNameTransformFn
+}
+
+// An ExternalNameTransformFn transforms the name of a managed resource into a
+// valid external name, for example by lowercasing or truncating it.
+type ExternalNameTransformFn func(name string) (string, error)
 
 // NewNameAsExternalName returns a new NameAsExternalName.
 func NewNameAsExternalName(c client.Client) *NameAsExternalName {
-	return &NameAsExternalName{client: c}
+	return NewNameAsExternalNameWithTransform(c, func(name string) (string, error) { return name, nil })
+}
+
+// NewNameAsExternalNameWithTransform returns a new NameAsExternalName that
+// uses the supplied function to transform the name of the managed resource
+// before writing it as its external name. The transform is only called when
+// the external name is not yet set.
+func NewNameAsExternalNameWithTransform(c client.Client, fn ExternalNameTransformFn) *NameAsExternalName {
+	return &NameAsExternalName{client: c, transform: fn}
 }
 
 // Initialize the given managed resource.
 func (a *NameAsExternalName) Initialize(ctx context.Context, mg resource.Managed) error {
-	if meta.GetExternalName(mg) != "" {
+	if resource.GetExternalName(mg) != "" {
 		return nil
 	}
-	meta.SetExternalName(mg, mg.GetName())
+	name, err := a.transform(mg.GetName())
+	if err != nil {
+		return errors.Wrap(err, errTransformExternalName)
+	}
+	resource.SetExternalName(mg, name)
 	return errors.Wrap(a.client.Update(ctx, mg), errUpdateManaged)
 }
 
+// A ProviderConfigMetadataPropagatorOption configures a
+// ProviderConfigMetadataPropagator.
+type ProviderConfigMetadataPropagatorOption func(*ProviderConfigMetadataPropagator)
+
+// PropagateLabels configures a ProviderConfigMetadataPropagator to propagate
+// the labels with the supplied keys.
+func PropagateLabels(keys ...string) ProviderConfigMetadataPropagatorOption {
+	return func(p *ProviderConfigMetadataPropagator) {
+		p.labels = append(p.labels, keys...)
+	}
+}
+
+// PropagateAnnotations configures a ProviderConfigMetadataPropagator to
+// propagate the annotations with the supplied keys.
+func PropagateAnnotations(keys ...string) ProviderConfigMetadataPropagatorOption {
+	return func(p *ProviderConfigMetadataPropagator) {
+		p.annotations = append(p.annotations, keys...)
+	}
+}
+
+// A ProviderConfigMetadataPropagator copies a configurable set of labels and
+// annotations from the ProviderConfig a managed resource references to the
+// managed resource. Labels and annotations that are already set on the managed
+// resource are never overwritten.
+type ProviderConfigMetadataPropagator struct {
+	client      client.Client
+	of          resource.ProviderConfig
+	labels      []string
+	annotations []string
+}
+
+// NewProviderConfigMetadataPropagator returns a new
+// ProviderConfigMetadataPropagator that propagates metadata from
+// ProviderConfigs of the supplied kind.
+func NewProviderConfigMetadataPropagator(c client.Client, of resource.ProviderConfig, o ...ProviderConfigMetadataPropagatorOption) *ProviderConfigMetadataPropagator {
+	p := &ProviderConfigMetadataPropagator{client: c, of: of}
+	for _, fn := range o {
+		fn(p)
+	}
+	return p
+}
+
+// Initialize the given managed resource by copying any configured labels and
+// annotations that it doesn't already have from the ProviderConfig it
+// references. It is a no-op if the managed resource doesn't reference a
+// ProviderConfig, or if the referenced ProviderConfig doesn't exist.
+func (p *ProviderConfigMetadataPropagator) Initialize(ctx context.Context, mg resource.Managed) error {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return nil
+	}
+
+	//nolint:forcetypeassert // Will always be a ProviderConfig.
+	pc := p.of.DeepCopyObject().(resource.ProviderConfig)
+	if err := p.client.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		// We don't want to block initialization if the ProviderConfig doesn't
+		// exist. Presumably connecting to the provider will fail instead.
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+
+	labels := missing(mg.GetLabels(), pc.GetLabels(), p.labels)
+	annotations := missing(mg.GetAnnotations(), pc.GetAnnotations(), p.annotations)
+	if len(labels) == 0 && len(annotations) == 0 {
+		return nil
+	}
+
+	if len(labels) > 0 {
+		meta.AddLabels(mg, labels)
+	}
+	if len(annotations) > 0 {
+		meta.AddAnnotations(mg, annotations)
+	}
+	return errors.Wrap(p.client.Update(ctx, mg), errUpdateManaged)
+}
+
+// missing returns the subset of the supplied keys that exist in from but not
+// in to, with their values in from.
+func missing(to, from map[string]string, keys []string) map[string]string {
+	out := map[string]string{}
+	for _, k := range keys {
+		if _, ok := to[k]; ok {
+			continue
+		}
+		if v, ok := from[k]; ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// A ConnectionSecretOwnerReferenceMode determines what kind of owner reference
+// an APISecretPublisher sets on the connection secrets it publishes.
+type ConnectionSecretOwnerReferenceMode string
+
+// Connection secret owner reference modes.
+const (
+	// OwnerReferenceModeController sets a controller reference to the managed
+	// resource. The connection secret is garbage collected when the managed
+	// resource is deleted.
+	OwnerReferenceModeController ConnectionSecretOwnerReferenceMode = "Controller"
+
+	// OwnerReferenceModeOwner sets an owner reference to the managed resource
+	// that is not a controller reference, and that doesn't block deletion of
+	// the managed resource. The connection secret is garbage collected when
+	// the managed resource is deleted.
+	OwnerReferenceModeOwner ConnectionSecretOwnerReferenceMode = "Owner"
+
+	// OwnerReferenceModeNone sets no owner reference. The connection secret
+	// is not garbage collected when the managed resource is deleted.
+	OwnerReferenceModeNone ConnectionSecretOwnerReferenceMode = "None"
+)
+
+// An APISecretPublisherOption configures an APISecretPublisher.
+type APISecretPublisherOption func(*APISecretPublisher)
+
+// WithConnectionSecretOwnerReferenceMode configures what kind of owner
+// reference an APISecretPublisher sets on the connection secrets it
+// publishes. A controller reference is set by default.
+func WithConnectionSecretOwnerReferenceMode(m ConnectionSecretOwnerReferenceMode) APISecretPublisherOption {
+	return func(a *APISecretPublisher) {
+		a.mode = m
+	}
+}
+
+// WithDeletionPolicyAlignment configures an APISecretPublisher to align the
+// deletion of connection secrets with the deletion policy of their managed
+// resource when it is deleted. The connection secret of a managed resource
+// with a deletion policy of Orphan survives the managed resource, while the
+// connection secret of a managed resource with a deletion policy of Delete is
+// deleted, even if it has no owner reference.
+func WithDeletionPolicyAlignment() APISecretPublisherOption {
+	return func(a *APISecretPublisher) {
+		a.alignDeletion = true
+	}
+}
+
 // An APISecretPublisher publishes ConnectionDetails by submitting a Secret to a
 // Kubernetes API server.
 type APISecretPublisher struct {
 	secret resource.Applicator
 	typer  runtime.ObjectTyper
+
+	client        client.Client
+	mode          ConnectionSecretOwnerReferenceMode
+	alignDeletion bool
 }
 
 // NewAPISecretPublisher returns a new APISecretPublisher.
-func NewAPISecretPublisher(c client.Client, ot runtime.ObjectTyper) *APISecretPublisher {
+func NewAPISecretPublisher(c client.Client, ot runtime.ObjectTyper, o ...APISecretPublisherOption) *APISecretPublisher {
 	// NOTE(negz): We transparently inject an APIPatchingApplicator in order to maintain
 	// backward compatibility with the original API of this function.
-	return &APISecretPublisher{
+	a := &APISecretPublisher{
 		secret: resource.NewApplicatorWithRetry(resource.NewAPIPatchingApplicator(c),
 			resource.IsAPIErrorWrapped, nil),
-		typer: ot,
+		typer:  ot,
+		client: c,
+		mode:   OwnerReferenceModeController,
+	}
+	for _, fn := range o {
+		fn(a)
 	}
+	return a
 }
 
 // PublishConnection publishes the supplied ConnectionDetails to a Secret in the
 // same namespace as the supplied Managed resource. It is a no-op if the secret
-// already exists with the supplied ConnectionDetails.
+// already exists with the supplied ConnectionDetails. The existing secret is
+// read before each publish, so publishing unchanged details doesn't write the
+// secret, while details that were modified by someone else are restored.
 func (a *APISecretPublisher) PublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c ConnectionDetails) (bool, error) {
 	// This resource does not want to expose a connection secret.
 	if o.GetWriteConnectionSecretToReference() == nil {
 		return false, nil
 	}
 
-	s := resource.ConnectionSecretFor(o, resource.MustGetKind(o, a.typer))
+	kind := resource.MustGetKind(o, a.typer)
+	s := resource.ConnectionSecretFor(o, kind)
+	switch a.mode {
+	case OwnerReferenceModeOwner:
+		s.SetOwnerReferences([]metav1.OwnerReference{meta.AsOwner(meta.TypedReferenceTo(o, kind))})
+	case OwnerReferenceModeNone:
+		s.SetOwnerReferences(nil)
+	case OwnerReferenceModeController:
+		// ConnectionSecretFor sets a controller reference.
+	}
 	s.Data = c
+	stale := false
 	err := a.secret.Apply(ctx, s,
+		func(_ context.Context, current, _ runtime.Object) error {
+			// The connection secret may still refer to a deleted managed
+			// resource with the same name as this one. We consider it ours,
+			// and update it to refer to this managed resource.
+			//nolint:forcetypeassert // Will always be a secret.
+			stale = resource.ReplaceStaleOwnerReferences(current.(*corev1.Secret), meta.AsOwner(meta.TypedReferenceTo(o, kind)))
+			return nil
+		},
 		resource.ConnectionSecretMustBeControllableBy(o.GetUID()),
 		resource.AllowUpdateIf(func(current, desired runtime.Object) bool {
 			// We consider the update to be a no-op and don't allow it if the
-			// current and existing secret data are identical.
+			// current and existing secret data are identical, and the secret
+			// had no stale owner references.
 			//nolint:forcetypeassert // Will always be a secret.
-			return !cmp.Equal(current.(*corev1.Secret).Data, desired.(*corev1.Secret).Data, cmpopts.EquateEmpty())
+			return stale || !cmp.Equal(current.(*corev1.Secret).Data, desired.(*corev1.Secret).Data, cmpopts.EquateEmpty())
 		}),
 	)
 	if resource.IsNotAllowed(err) {
@@ -123,25 +323,267 @@ func (a *APISecretPublisher) PublishConnection(ctx context.Context, o resource.C
 	return true, nil
 }
 
-// UnpublishConnection is no-op since PublishConnection only creates resources
-// that will be garbage collected by Kubernetes when the managed resource is
-// deleted.
-func (a *APISecretPublisher) UnpublishConnection(_ context.Context, _ resource.ConnectionSecretOwner, _ ConnectionDetails) error {
-	return nil
+// UnpublishConnection is a no-op by default, since PublishConnection only
+// creates resources that will be garbage collected by Kubernetes when the
+// managed resource is deleted. If deletion policy alignment is enabled it
+// removes the managed resource's owner references from the connection secret
+// of a managed resource with a deletion policy of Orphan, and deletes the
+// connection secret of a managed resource with a deletion policy of Delete.
+func (a *APISecretPublisher) UnpublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, _ ConnectionDetails) error {
+	if !a.alignDeletion || o.GetWriteConnectionSecretToReference() == nil {
+		return nil
+	}
+	or, ok := o.(resource.Orphanable)
+	if !ok {
+		return nil
+	}
+
+	ref := o.GetWriteConnectionSecretToReference()
+	s := &corev1.Secret{}
+	if err := a.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetSecret)
+	}
+	if err := resource.ConnectionSecretMustBeControllableBy(o.GetUID())(ctx, s, nil); err != nil {
+		// We don't touch connection secrets we don't control.
+		return nil //nolint:nilerr // Not being able to control the secret isn't an error.
+	}
+
+	if or.GetDeletionPolicy() == xpv1.DeletionOrphan {
+		refs := s.GetOwnerReferences()
+		kept := make([]metav1.OwnerReference, 0, len(refs))
+		for _, r := range refs {
+			if r.UID != o.GetUID() {
+				kept = append(kept, r)
+			}
+		}
+		if len(kept) == len(refs) {
+			return nil
+		}
+		s.SetOwnerReferences(kept)
+		return errors.Wrap(a.client.Update(ctx, s), errOrphanSecret)
+	}
+
+	return errors.Wrap(resource.IgnoreNotFound(a.client.Delete(ctx, s)), errDeleteSecret)
+}
+
+// A ReferenceResolutionPolicy determines when references are resolved.
+type ReferenceResolutionPolicy string
+
+// Reference resolution policies.
+const (
+	// ReferenceResolutionAlways resolves references every time a managed
+	// resource is reconciled.
+	ReferenceResolutionAlways ReferenceResolutionPolicy = "Always"
+
+	// ReferenceResolutionIfNotResolved resolves references only if the
+	// managed resource's spec, or any of the resources it references, have
+	// changed since its references were last resolved.
+	ReferenceResolutionIfNotResolved ReferenceResolutionPolicy = "IfNotResolved"
+)
+
+// An APISimpleReferenceResolverOption configures an
+// APISimpleReferenceResolver.
+type APISimpleReferenceResolverOption func(*APISimpleReferenceResolver)
+
+// WithResolutionPolicy configures when an APISimpleReferenceResolver resolves
+// references. References are always resolved by default.
+func WithResolutionPolicy(p ReferenceResolutionPolicy) APISimpleReferenceResolverOption {
+	return func(r *APISimpleReferenceResolver) {
+		r.policy = p
+	}
+}
+
+// WithCycleDetection configures an APISimpleReferenceResolver to detect when a
+// managed resource can't resolve its references because it and a resource it
+// references reference each other, and neither is ready. Rather than blocking
+// forever, the resolver persists any references it could resolve and returns a
+// CyclicReferenceError. A managed resource that fails to resolve its
+// references records the resources it read in its
+// AnnotationKeyUnresolvedReferences annotation. A resource references another
+// if that annotation records the other's kind and name, so cycles are only
+// detected between resources whose references are resolved with cycle
+// detection enabled.
+func WithCycleDetection() APISimpleReferenceResolverOption {
+	return func(r *APISimpleReferenceResolver) {
+		r.detectCycles = true
+	}
+}
+
+// A CyclicReferenceError indicates that the references of a managed resource
+// could only be partially resolved because it and a resource it references
+// reference each other. Reconciliation may proceed, but should be requeued so
+// that the remaining references may be resolved once the referenced resource
+// is ready.
+type CyclicReferenceError struct {
+	error
+}
+
+// Unwrap returns the underlying resolution error.
+func (e CyclicReferenceError) Unwrap() error {
+	return e.error
+}
+
+// IsCyclicReference returns true if the supplied error is, or wraps, a
+// CyclicReferenceError.
+func IsCyclicReference(err error) bool {
+	ce := CyclicReferenceError{}
+	return errors.As(err, &ce)
 }
 
 // An APISimpleReferenceResolver resolves references from one managed resource
 // to others by calling the referencing resource's ResolveReferences method, if
 // any.
 type APISimpleReferenceResolver struct {
-	client client.Client
+	client       client.Client
+	policy       ReferenceResolutionPolicy
+	detectCycles bool
 }
 
 // NewAPISimpleReferenceResolver returns a ReferenceResolver that resolves
 // references from one managed resource to others by calling the referencing
 // resource's ResolveReferences method, if any.
-func NewAPISimpleReferenceResolver(c client.Client) *APISimpleReferenceResolver {
-	return &APISimpleReferenceResolver{client: c}
+func NewAPISimpleReferenceResolver(c client.Client, o ...APISimpleReferenceResolverOption) *APISimpleReferenceResolver {
+	r := &APISimpleReferenceResolver{client: c, policy: ReferenceResolutionAlways}
+	for _, fn := range o {
+		fn(r)
+	}
+	return r
+}
+
+// resolvedReferences is recorded in the AnnotationKeyResolvedReferences
+// annotation of a managed resource after its references are resolved.
+type resolvedReferences struct {
+	Generation int64                 `json:"generation"`
+	References []resolvedReferenceTo `json:"references,omitempty"`
+}
+
+// unresolvedReferences is recorded in the AnnotationKeyUnresolvedReferences
+// annotation of a managed resource that fails to resolve its references.
+type unresolvedReferences struct {
+	References []resolvedReferenceTo `json:"references,omitempty"`
+}
+
+type resolvedReferenceTo struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	Generation int64  `json:"generation"`
+}
+
+// A trackingReader records the resources read by Get while resolving
+// references. Resources read by List are not recorded; they're only read when
+// resolving a selector, which results in a reference being set.
+type trackingReader struct {
+	client.Reader
+	scheme *runtime.Scheme
+
+	read       []client.Object
+	refs       []resolvedReferenceTo
+	incomplete bool
+}
+
+func (r *trackingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if err := r.Reader.Get(ctx, key, obj, opts...); err != nil {
+		return err
+	}
+	// Resolvers typically reuse the same object for each Get, so we must
+	// record a copy.
+	if o, ok := obj.DeepCopyObject().(client.Object); ok {
+		r.read = append(r.read, o)
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() && r.scheme != nil {
+		gvk, _ = apiutil.GVKForObject(obj, r.scheme)
+	}
+	if gvk.Empty() {
+		// We can't record a resource we can't identify, so we must assume
+		// our references aren't resolved next time around.
+		r.incomplete = true
+		return nil
+	}
+	v, k := gvk.ToAPIVersionAndKind()
+	r.refs = append(r.refs, resolvedReferenceTo{APIVersion: v, Kind: k, Namespace: key.Namespace, Name: key.Name, Generation: obj.GetGeneration()})
+	return nil
+}
+
+// referencesTo returns true if any of the supplied resources read the resource
+// with the supplied namespace, name, and kind when it most recently failed to
+// resolve its references, according to its AnnotationKeyUnresolvedReferences
+// annotation. Resources are matched on their group and kind, ignoring their
+// version. If the kind is unknown any resource with the supplied namespace and
+// name is considered.
+func referencesTo(nn types.NamespacedName, gvk schema.GroupVersionKind, objs ...client.Object) bool {
+	for _, o := range objs {
+		v, ok := o.GetAnnotations()[meta.AnnotationKeyUnresolvedReferences]
+		if !ok {
+			continue
+		}
+		ur := &unresolvedReferences{}
+		if err := json.Unmarshal([]byte(v), ur); err != nil {
+			continue
+		}
+		for _, ref := range ur.References {
+			if ref.Namespace != nn.Namespace || ref.Name != nn.Name {
+				continue
+			}
+			if gvk.Empty() {
+				return true
+			}
+			gv, err := schema.ParseGroupVersion(ref.APIVersion)
+			if err != nil {
+				continue
+			}
+			if gv.Group == gvk.Group && ref.Kind == gvk.Kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolved returns true if the supplied managed resource's references were
+// resolved at its current generation, and none of the resources it referenced
+// have changed since.
+func (a *APISimpleReferenceResolver) resolved(ctx context.Context, mg resource.Managed) bool {
+	v, ok := mg.GetAnnotations()[meta.AnnotationKeyResolvedReferences]
+	if !ok {
+		return false
+	}
+	rr := &resolvedReferences{}
+	if err := json.Unmarshal([]byte(v), rr); err != nil {
+		return false
+	}
+	if rr.Generation != mg.GetGeneration() {
+		return false
+	}
+	for _, ref := range rr.References {
+		m := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: ref.APIVersion, Kind: ref.Kind}}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, m); err != nil {
+			return false
+		}
+		if m.GetGeneration() != ref.Generation {
+			return false
+		}
+	}
+	return true
+}
+
+// gvkOf returns the GroupVersionKind of the supplied managed resource, which
+// is empty if it's unknown.
+func (a *APISimpleReferenceResolver) gvkOf(mg resource.Managed) schema.GroupVersionKind {
+	if gvk := mg.GetObjectKind().GroupVersionKind(); !gvk.Empty() {
+		return gvk
+	}
+	sc := a.client.Scheme()
+	if sc == nil {
+		return schema.GroupVersionKind{}
+	}
+	gvk, err := apiutil.GVKForObject(mg, sc)
+	if err != nil {
+		return schema.GroupVersionKind{}
+	}
+	return gvk
 }
 
 func prepareJSONMerge(existing, resolved runtime.Object) ([]byte, error) {
@@ -176,21 +618,80 @@ func (a *APISimpleReferenceResolver) ResolveReferences(ctx context.Context, mg r
 		return nil
 	}
 
+	if a.policy == ReferenceResolutionIfNotResolved && a.resolved(ctx, mg) {
+		return nil
+	}
+
+	// Track the resources we read while resolving references so that we can
+	// tell whether they've changed next time around, or whether they
+	// reference us.
+	var tr *trackingReader
+	var r client.Reader = a.client
+	if a.policy == ReferenceResolutionIfNotResolved || a.detectCycles {
+		tr = &trackingReader{Reader: a.client, scheme: a.client.Scheme()}
+		r = tr
+	}
+
+	// If we're blocked on a resource that references us we persist whatever
+	// references we could resolve, so that the other resource can proceed.
+	// When detecting cycles we persist them along with the resources we read
+	// even if we're not blocked on such a resource, so that it can tell that
+	// it's blocked on us.
+	var cerr error
 	existing := mg.DeepCopyObject()
-	if err := rr.ResolveReferences(ctx, a.client); err != nil {
-		return errors.Wrap(err, errResolveReferences)
+	if err := rr.ResolveReferences(ctx, r); err != nil {
+		if !a.detectCycles {
+			return errors.Wrap(err, errResolveReferences)
+		}
+		cerr = errors.Wrap(err, errResolveReferences)
+		if referencesTo(types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}, a.gvkOf(mg), tr.read...) {
+			cerr = CyclicReferenceError{error: errors.Wrap(err, errCyclicReference)}
+		}
+	}
+
+	if a.detectCycles {
+		// Record the resources we read if we failed to resolve our
+		// references, so that they can tell we reference them if they in
+		// turn fail to resolve theirs. We stop recording them once our
+		// references are resolved.
+		ur := unresolvedReferences{}
+		if cerr != nil {
+			ur.References = tr.refs
+		}
+		_, recorded := mg.GetAnnotations()[meta.AnnotationKeyUnresolvedReferences]
+		if cerr != nil || recorded {
+			v, err := json.Marshal(ur)
+			if err != nil {
+				return errors.Wrap(err, errMarshalResolvedRefs)
+			}
+			meta.AddAnnotations(mg, map[string]string{meta.AnnotationKeyUnresolvedReferences: string(v)})
+		}
+	}
+
+	if tr != nil && !tr.incomplete && cerr == nil && a.policy == ReferenceResolutionIfNotResolved {
+		// If resolution changes the managed resource's spec its generation
+		// will be incremented, so we'll resolve its references once more
+		// before we start skipping resolution.
+		v, err := json.Marshal(resolvedReferences{Generation: mg.GetGeneration(), References: tr.refs})
+		if err != nil {
+			return errors.Wrap(err, errMarshalResolvedRefs)
+		}
+		meta.AddAnnotations(mg, map[string]string{meta.AnnotationKeyResolvedReferences: string(v)})
 	}
 
 	if cmp.Equal(existing, mg, cmpopts.EquateEmpty()) {
 		// The resource didn't change during reference resolution.
-		return nil
+		return cerr
 	}
 
 	patch, err := prepareJSONMerge(existing, mg)
 	if err != nil {
 		return err
 	}
-	return errors.Wrap(a.client.Patch(ctx, mg, client.RawPatch(types.ApplyPatchType, patch), client.FieldOwner(fieldOwnerAPISimpleRefResolver), client.ForceOwnership), errPatchManaged)
+	if err := a.client.Patch(ctx, mg, client.RawPatch(types.ApplyPatchType, patch), client.FieldOwner(fieldOwnerAPISimpleRefResolver), client.ForceOwnership); err != nil {
+		return errors.Wrap(err, errPatchManaged)
+	}
+	return cerr
 }
 
 // A RetryingCriticalAnnotationUpdater is a CriticalAnnotationUpdater that
@@ -227,3 +728,127 @@ func (u *RetryingCriticalAnnotationUpdater) UpdateCriticalAnnotations(ctx contex
 	})
 	return errors.Wrap(err, errUpdateCriticalAnnotations)
 }
+
+// A PatchingCriticalAnnotationUpdater is a CriticalAnnotationUpdater that
+// persists annotations using a JSON merge patch, retrying in the face of
+// transient API server errors. Unlike a RetryingCriticalAnnotationUpdater it
+// sends only the annotations, so it can't clobber concurrent changes to the
+// rest of the object. The patch is built from the object's current
+// annotations, so it can add or change annotations but never remove one.
+type PatchingCriticalAnnotationUpdater struct {
+	client client.Client
+}
+
+// NewPatchingCriticalAnnotationUpdater returns a CriticalAnnotationUpdater
+// that patches annotations, retrying in the face of transient API server
+// errors.
+func NewPatchingCriticalAnnotationUpdater(c client.Client) *PatchingCriticalAnnotationUpdater {
+	return &PatchingCriticalAnnotationUpdater{client: c}
+}
+
+// UpdateCriticalAnnotations patches (i.e. persists) the annotations of the
+// supplied Object. It retries in the face of transient API server errors,
+// such as conflicts, timeouts, and throttling, several times in order to
+// ensure annotations that contain critical state are persisted. The patch
+// contains only the annotations, and doesn't require the supplied Object to
+// be at its latest version. Annotations removed from the supplied Object
+// aren't removed by the patch, because a JSON merge patch only removes fields
+// that it explicitly sets to null.
+func (u *PatchingCriticalAnnotationUpdater) UpdateCriticalAnnotations(ctx context.Context, o client.Object) error {
+	p, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": o.GetAnnotations()}})
+	if err != nil {
+		return errors.Wrap(err, errMarshalAnnotations)
+	}
+	err = retry.OnError(retry.DefaultRetry, errors.IsRetryable, func() error {
+		return u.client.Patch(ctx, o, client.RawPatch(types.MergePatchType, p))
+	})
+	return errors.Wrap(err, errPatchCriticalAnnotations)
+}
+
+// A ManagedUpdater persists changes to the metadata and spec of a managed
+// resource, such as late initialized fields.
+type ManagedUpdater interface {
+	// UpdateManaged persists changes made to the supplied managed resource
+	// since it was in the supplied original state.
+	UpdateManaged(ctx context.Context, original, mg resource.Managed) error
+}
+
+// A ManagedUpdaterFn is a function that satisfies the ManagedUpdater
+// interface.
+type ManagedUpdaterFn func(ctx context.Context, original, mg resource.Managed) error
+
+// UpdateManaged calls ManagedUpdaterFn function.
+func (fn ManagedUpdaterFn) UpdateManaged(ctx context.Context, original, mg resource.Managed) error {
+	return fn(ctx, original, mg)
+}
+
+// An APIUpdatingManagedUpdater persists changes to a managed resource by
+// updating the entire object. The update fails if the managed resource isn't
+// at its latest version.
+type APIUpdatingManagedUpdater struct {
+	client client.Client
+}
+
+// NewAPIUpdatingManagedUpdater returns a ManagedUpdater that updates the
+// entire managed resource.
+func NewAPIUpdatingManagedUpdater(c client.Client) *APIUpdatingManagedUpdater {
+	return &APIUpdatingManagedUpdater{client: c}
+}
+
+// UpdateManaged updates the supplied managed resource.
+func (u *APIUpdatingManagedUpdater) UpdateManaged(ctx context.Context, _, mg resource.Managed) error {
+	return u.client.Update(ctx, mg)
+}
+
+// An APIPatchingManagedUpdater persists changes to a managed resource by
+// sending a JSON merge patch containing only the fields that changed. It
+// doesn't clobber concurrent changes to other fields, and doesn't fail if the
+// managed resource isn't at its latest version.
+type APIPatchingManagedUpdater struct {
+	client client.Client
+}
+
+// NewAPIPatchingManagedUpdater returns a ManagedUpdater that patches the
+// fields of a managed resource that changed.
+func NewAPIPatchingManagedUpdater(c client.Client) *APIPatchingManagedUpdater {
+	return &APIPatchingManagedUpdater{client: c}
+}
+
+// UpdateManaged patches the fields of the supplied managed resource that
+// changed since it was in the supplied original state.
+func (u *APIPatchingManagedUpdater) UpdateManaged(ctx context.Context, original, mg resource.Managed) error {
+	return u.client.Patch(ctx, mg, client.MergeFrom(original))
+}
+
+// A ConditionPreservingManagedUpdater persists changes to a managed resource
+// using another ManagedUpdater, then restores the status conditions the
+// managed resource had before it was updated. The API server responds to an
+// update with its own view of the managed resource's status, so without this
+// any conditions set since the managed resource was read, for example while
+// observing it, would be lost.
+type ConditionPreservingManagedUpdater struct {
+	wrapped ManagedUpdater
+}
+
+// NewConditionPreservingManagedUpdater returns a ManagedUpdater that restores
+// the status conditions of a managed resource after updating it using the
+// supplied ManagedUpdater.
+func NewConditionPreservingManagedUpdater(u ManagedUpdater) *ConditionPreservingManagedUpdater {
+	return &ConditionPreservingManagedUpdater{wrapped: u}
+}
+
+// UpdateManaged updates the supplied managed resource, then restores the
+// status conditions it had before the update.
+func (u *ConditionPreservingManagedUpdater) UpdateManaged(ctx context.Context, original, mg resource.Managed) error {
+	cs, err := conditionsOf(mg)
+	if err != nil {
+		return err
+	}
+	// Copy the conditions, in case they share memory with mg's status.
+	cs = append([]xpv1.Condition(nil), cs...)
+	if err := u.wrapped.UpdateManaged(ctx, original, mg); err != nil {
+		return err
+	}
+	mg.SetConditions(cs...)
+	return nil
+}
@@ -18,24 +18,41 @@ package managed
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 )
 
-var _ Initializer = &NameAsExternalName{}
+var (
+	_ Initializer = &NameAsExternalName{}
+	_ Initializer = &ProviderConfigMetadataPropagator{}
+)
+
+type externalNamedManaged struct {
+	fake.Managed
+	fake.ExternalNameAccessor
+}
 
 func TestNameAsExternalName(t *testing.T) {
 	type args struct {
@@ -85,6 +102,36 @@ func TestNameAsExternalName(t *testing.T) {
 				}},
 			},
 		},
+		"UpdateSuccessfulExternalNameAccessor": {
+			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			args: args{
+				ctx: context.Background(),
+				mg:  &externalNamedManaged{Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: testExternalName}}},
+			},
+			want: want{
+				err: nil,
+				mg: &externalNamedManaged{
+					Managed:              fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: testExternalName}},
+					ExternalNameAccessor: fake.ExternalNameAccessor{ExternalName: testExternalName},
+				},
+			},
+		},
+		"UpdateNotNeededExternalNameAccessor": {
+			args: args{
+				ctx: context.Background(),
+				mg: &externalNamedManaged{
+					Managed:              fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: testExternalName}},
+					ExternalNameAccessor: fake.ExternalNameAccessor{ExternalName: "some-name"},
+				},
+			},
+			want: want{
+				err: nil,
+				mg: &externalNamedManaged{
+					Managed:              fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: testExternalName}},
+					ExternalNameAccessor: fake.ExternalNameAccessor{ExternalName: "some-name"},
+				},
+			},
+		},
 		"UpdateNotNeeded": {
 			args: args{
 				ctx: context.Background(),
@@ -117,6 +164,269 @@ func TestNameAsExternalName(t *testing.T) {
 	}
 }
 
+func TestNameAsExternalNameWithTransform(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+		mg  resource.Managed
+	}
+
+	errBoom := errors.New("boom")
+
+	// Lowercase and truncate names to 8 characters.
+	truncate := func(name string) (string, error) {
+		name = strings.ToLower(name)
+		if len(name) > 8 {
+			name = name[:8]
+		}
+		return name, nil
+	}
+
+	cases := map[string]struct {
+		reason string
+		client client.Client
+		fn     ExternalNameTransformFn
+		args   args
+		want   want
+	}{
+		"TransformError": {
+			reason: "Errors transforming the name should be returned.",
+			fn:     func(_ string) (string, error) { return "", errBoom },
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "Cool-Resource"}},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errTransformExternalName),
+				mg:  &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "Cool-Resource"}},
+			},
+		},
+		"TruncateAndLowercase": {
+			reason: "The transformed name should be set as the external name.",
+			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			fn:     truncate,
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "Cool-Resource"}},
+			},
+			want: want{
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+					Name:        "Cool-Resource",
+					Annotations: map[string]string{meta.AnnotationKeyExternalName: "cool-res"},
+				}},
+			},
+		},
+		"ExternalNameAlreadySet": {
+			reason: "The transform should not be called if the external name is already set.",
+			fn: func(_ string) (string, error) {
+				t.Error("transform called unexpectedly")
+				return "", nil
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+					Name:        "Cool-Resource",
+					Annotations: map[string]string{meta.AnnotationKeyExternalName: "Already-Set"},
+				}},
+			},
+			want: want{
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+					Name:        "Cool-Resource",
+					Annotations: map[string]string{meta.AnnotationKeyExternalName: "Already-Set"},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := NewNameAsExternalNameWithTransform(tc.client, tc.fn)
+			err := api.Initialize(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("\n%s\nInitialize(...) Managed: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProviderConfigMetadataPropagator(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		err error
+		mg  resource.Managed
+	}
+
+	errBoom := errors.New("boom")
+	pcRef := fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "cool-pc"}}
+	withMetadata := func(obj client.Object) error {
+		obj.SetLabels(map[string]string{"team": "platform", "cost-center": "42", "ignored": "label"})
+		obj.SetAnnotations(map[string]string{"owner": "platform@example.org", "ignored": "annotation"})
+		return nil
+	}
+
+	cases := map[string]struct {
+		reason string
+		client client.Client
+		o      []ProviderConfigMetadataPropagatorOption
+		args   args
+		want   want
+	}{
+		"NoProviderConfigReference": {
+			reason: "We should do nothing if the managed resource doesn't reference a provider config.",
+			o:      []ProviderConfigMetadataPropagatorOption{PropagateLabels("team")},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{},
+			},
+			want: want{
+				mg: &fake.Managed{},
+			},
+		},
+		"ProviderConfigNotFound": {
+			reason: "We should do nothing if the referenced provider config doesn't exist.",
+			client: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "cool-pc"))},
+			o:      []ProviderConfigMetadataPropagatorOption{PropagateLabels("team")},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{ProviderConfigReferencer: pcRef},
+			},
+			want: want{
+				mg: &fake.Managed{ProviderConfigReferencer: pcRef},
+			},
+		},
+		"GetProviderConfigError": {
+			reason: "We should return any error encountered getting the referenced provider config.",
+			client: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			o:      []ProviderConfigMetadataPropagatorOption{PropagateLabels("team")},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{ProviderConfigReferencer: pcRef},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderConfig),
+				mg:  &fake.Managed{ProviderConfigReferencer: pcRef},
+			},
+		},
+		"CopyMetadata": {
+			reason: "We should copy the configured labels and annotations from the provider config to the managed resource.",
+			client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withMetadata),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			o: []ProviderConfigMetadataPropagatorOption{
+				PropagateLabels("team", "cost-center", "missing"),
+				PropagateAnnotations("owner"),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{ProviderConfigReferencer: pcRef},
+			},
+			want: want{
+				mg: &fake.Managed{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"team": "platform", "cost-center": "42"},
+						Annotations: map[string]string{"owner": "platform@example.org"},
+					},
+					ProviderConfigReferencer: pcRef,
+				},
+			},
+		},
+		"DoNotOverwrite": {
+			reason: "We should not overwrite labels or annotations that are already set on the managed resource.",
+			client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withMetadata),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			o: []ProviderConfigMetadataPropagatorOption{
+				PropagateLabels("team", "cost-center"),
+				PropagateAnnotations("owner"),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &fake.Managed{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"team": "data"},
+						Annotations: map[string]string{"owner": "data@example.org"},
+					},
+					ProviderConfigReferencer: pcRef,
+				},
+			},
+			want: want{
+				mg: &fake.Managed{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"team": "data", "cost-center": "42"},
+						Annotations: map[string]string{"owner": "data@example.org"},
+					},
+					ProviderConfigReferencer: pcRef,
+				},
+			},
+		},
+		"UpdateNotNeeded": {
+			reason: "We should not update the managed resource if it already has all the configured labels and annotations.",
+			client: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, withMetadata),
+			},
+			o: []ProviderConfigMetadataPropagatorOption{PropagateLabels("team")},
+			args: args{
+				ctx: context.Background(),
+				mg: &fake.Managed{
+					ObjectMeta:               metav1.ObjectMeta{Labels: map[string]string{"team": "data"}},
+					ProviderConfigReferencer: pcRef,
+				},
+			},
+			want: want{
+				mg: &fake.Managed{
+					ObjectMeta:               metav1.ObjectMeta{Labels: map[string]string{"team": "data"}},
+					ProviderConfigReferencer: pcRef,
+				},
+			},
+		},
+		"UpdateManagedError": {
+			reason: "We should return any error encountered updating the managed resource.",
+			client: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withMetadata),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			o: []ProviderConfigMetadataPropagatorOption{PropagateLabels("team")},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{ProviderConfigReferencer: pcRef},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateManaged),
+				mg: &fake.Managed{
+					ObjectMeta:               metav1.ObjectMeta{Labels: map[string]string{"team": "platform"}},
+					ProviderConfigReferencer: pcRef,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := NewProviderConfigMetadataPropagator(tc.client, &fake.ProviderConfig{}, tc.o...)
+			err := p.Initialize(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\np.Initialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\np.Initialize(...) Managed: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestAPISecretPublisher(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -222,7 +532,7 @@ func TestAPISecretPublisher(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			a := &APISecretPublisher{tc.fields.secret, tc.fields.typer}
+			a := &APISecretPublisher{secret: tc.fields.secret, typer: tc.fields.typer}
 			got, gotErr := a.PublishConnection(tc.args.ctx, tc.args.mg, tc.args.c)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\nPublish(...): -wantErr, +gotErr:\n%s", tc.reason, diff)
@@ -234,56 +544,364 @@ func TestAPISecretPublisher(t *testing.T) {
 	}
 }
 
-type mockSimpleReferencer struct {
-	resource.Managed
+func TestAPISecretPublisherOwnerReferenceMode(t *testing.T) {
+	mg := &fake.Managed{
+		ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: types.UID("cool-uid")},
+		ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{
+			Namespace: "coolnamespace",
+			Name:      "coolsecret",
+		}},
+	}
+	ref := meta.TypedReferenceTo(mg, fake.GVK(mg))
 
-	MockResolveReferences func(context.Context, client.Reader) error `json:"-"`
-}
+	cases := map[string]struct {
+		reason string
+		o      []APISecretPublisherOption
+		want   []metav1.OwnerReference
+	}{
+		"Default": {
+			reason: "By default the connection secret should be controlled by the managed resource.",
+			want:   []metav1.OwnerReference{meta.AsController(ref)},
+		},
+		"Controller": {
+			reason: "The connection secret should be controlled by the managed resource in Controller mode.",
+			o:      []APISecretPublisherOption{WithConnectionSecretOwnerReferenceMode(OwnerReferenceModeController)},
+			want:   []metav1.OwnerReference{meta.AsController(ref)},
+		},
+		"Owner": {
+			reason: "The connection secret should be owned, but not controlled, by the managed resource in Owner mode.",
+			o:      []APISecretPublisherOption{WithConnectionSecretOwnerReferenceMode(OwnerReferenceModeOwner)},
+			want:   []metav1.OwnerReference{meta.AsOwner(ref)},
+		},
+		"None": {
+			reason: "The connection secret should have no owner references in None mode.",
+			o:      []APISecretPublisherOption{WithConnectionSecretOwnerReferenceMode(OwnerReferenceModeNone)},
+		},
+	}
 
-func (r *mockSimpleReferencer) ResolveReferences(ctx context.Context, c client.Reader) error {
-	return r.MockResolveReferences(ctx, c)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []metav1.OwnerReference
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "coolsecret")),
+				MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+					got = obj.GetOwnerReferences()
+					return nil
+				}),
+			}
+			a := NewAPISecretPublisher(c, fake.SchemeWith(&fake.Managed{}), tc.o...)
+			if _, err := a.PublishConnection(context.Background(), mg, ConnectionDetails{"cool": {42}}); err != nil {
+				t.Fatalf("\n%s\nPublishConnection(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nPublishConnection(...): -want owner references, +got owner references:\n%s", tc.reason, diff)
+			}
+		})
+	}
 }
 
-func (r *mockSimpleReferencer) DeepCopyObject() runtime.Object {
-	return &mockSimpleReferencer{Managed: r.Managed.DeepCopyObject().(resource.Managed)}
-}
+func TestAPISecretPublisherStaleOwnerReference(t *testing.T) {
+	mg := &fake.Managed{
+		ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: types.UID("new-uid")},
+		ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{
+			Namespace: "coolnamespace",
+			Name:      "coolsecret",
+		}},
+	}
+	cd := ConnectionDetails{"cool": {42}}
 
-func (r *mockSimpleReferencer) Equal(s *mockSimpleReferencer) bool {
-	return cmp.Equal(r.Managed, s.Managed)
-}
+	// The existing connection secret has the same data, but is controlled by
+	// a deleted managed resource of the same name.
+	old := &fake.Managed{
+		ObjectMeta:               metav1.ObjectMeta{Name: "cool", UID: types.UID("old-uid")},
+		ConnectionSecretWriterTo: mg.ConnectionSecretWriterTo,
+	}
+	existing := resource.ConnectionSecretFor(old, fake.GVK(old))
+	existing.Data = cd
 
-func TestResolveReferences(t *testing.T) {
-	errBoom := errors.New("boom")
+	var got []metav1.OwnerReference
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			existing.DeepCopyInto(obj.(*corev1.Secret))
+			return nil
+		}),
+		MockPatch: test.NewMockPatchFn(nil, func(obj client.Object) error {
+			got = obj.GetOwnerReferences()
+			return nil
+		}),
+	}
+	a := NewAPISecretPublisher(c, fake.SchemeWith(&fake.Managed{}))
+	published, err := a.PublishConnection(context.Background(), mg, cd)
+	if err != nil {
+		t.Fatalf("PublishConnection(...): unexpected error: %s", err)
+	}
+	if !published {
+		t.Errorf("PublishConnection(...): want published when the connection secret had a stale owner reference")
+	}
+	want := []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, fake.GVK(mg)))}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PublishConnection(...): -want owner references, +got owner references:\n%s", diff)
+	}
+}
 
-	different := &fake.Managed{}
+func TestAPISecretPublisherRepeatedPublish(t *testing.T) {
+	mg := &fake.Managed{
+		ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: types.UID("cool-uid")},
+		ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{
+			Namespace: "coolnamespace",
+			Name:      "coolsecret",
+		}},
+	}
 
 	type args struct {
-		ctx context.Context
-		mg  resource.Managed
+		first  ConnectionDetails
+		modify func(s *corev1.Secret)
+		second ConnectionDetails
+	}
+	type want struct {
+		published []bool
+		writes    int
+		data      map[string][]byte
 	}
 
 	cases := map[string]struct {
 		reason string
-		c      client.Client
 		args   args
-		want   error
+		want   want
 	}{
-		"NoReferencersFound": {
-			reason: "Should return early without error when the managed resource has no references.",
+		"IdenticalDetails": {
+			reason: "Publishing identical connection details twice should write the connection secret once.",
 			args: args{
-				ctx: context.Background(),
-				mg:  &fake.Managed{},
+				first:  ConnectionDetails{"cool": []byte("data")},
+				second: ConnectionDetails{"cool": []byte("data")},
 			},
-			want: nil,
-		},
-		"ResolveReferencesError": {
-			reason: "Should return errors encountered while resolving references.",
-			c: &test.MockClient{
-				MockUpdate: test.NewMockUpdateFn(nil),
+			want: want{
+				published: []bool{true, false},
+				writes:    1,
+				data:      map[string][]byte{"cool": []byte("data")},
 			},
+		},
+		"ChangedDetails": {
+			reason: "Publishing changed connection details should write the connection secret again.",
 			args: args{
-				ctx: context.Background(),
-				mg: &mockSimpleReferencer{
+				first:  ConnectionDetails{"cool": []byte("data")},
+				second: ConnectionDetails{"cool": []byte("newdata")},
+			},
+			want: want{
+				published: []bool{true, true},
+				writes:    2,
+				data:      map[string][]byte{"cool": []byte("newdata")},
+			},
+		},
+		"ExternallyModified": {
+			reason: "Publishing identical connection details should write the connection secret again if it was modified by someone else.",
+			args: args{
+				first: ConnectionDetails{"cool": []byte("data")},
+				modify: func(s *corev1.Secret) {
+					s.Data = map[string][]byte{"cool": []byte("tampered")}
+				},
+				second: ConnectionDetails{"cool": []byte("data")},
+			},
+			want: want{
+				published: []bool{true, true},
+				writes:    2,
+				data:      map[string][]byte{"cool": []byte("data")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			got := want{}
+			c := ctrlfake.NewClientBuilder().
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						got.writes++
+						return c.Create(ctx, obj, opts...)
+					},
+					Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						got.writes++
+						return c.Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+			a := NewAPISecretPublisher(c, fake.SchemeWith(&fake.Managed{}))
+			key := types.NamespacedName{Namespace: "coolnamespace", Name: "coolsecret"}
+
+			published, err := a.PublishConnection(ctx, mg, tc.args.first)
+			if err != nil {
+				t.Fatalf("\n%s\nPublishConnection(...): unexpected error: %s", tc.reason, err)
+			}
+			got.published = append(got.published, published)
+
+			if tc.args.modify != nil {
+				s := &corev1.Secret{}
+				if err := c.Get(ctx, key, s); err != nil {
+					t.Fatalf("\n%s\nGet(...): unexpected error: %s", tc.reason, err)
+				}
+				tc.args.modify(s)
+				if err := c.Update(ctx, s); err != nil {
+					t.Fatalf("\n%s\nUpdate(...): unexpected error: %s", tc.reason, err)
+				}
+			}
+
+			published, err = a.PublishConnection(ctx, mg, tc.args.second)
+			if err != nil {
+				t.Fatalf("\n%s\nPublishConnection(...): unexpected error: %s", tc.reason, err)
+			}
+			got.published = append(got.published, published)
+
+			s := &corev1.Secret{}
+			if err := c.Get(ctx, key, s); err != nil {
+				t.Fatalf("\n%s\nGet(...): unexpected error: %s", tc.reason, err)
+			}
+			got.data = s.Data
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nPublishConnection(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAPISecretPublisherUnpublishConnection(t *testing.T) {
+	uid := types.UID("cool-uid")
+	other := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: types.UID("other-uid")}
+
+	secret := func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "coolnamespace",
+				Name:            "coolsecret",
+				OwnerReferences: []metav1.OwnerReference{meta.AsController(&xpv1.TypedReference{UID: uid}), other},
+			},
+			Type: resource.SecretTypeConnection,
+		}
+	}
+	managed := func(p xpv1.DeletionPolicy) *fake.Managed {
+		mg := &fake.Managed{
+			ObjectMeta: metav1.ObjectMeta{UID: uid},
+			ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{
+				Namespace: "coolnamespace",
+				Name:      "coolsecret",
+			}},
+		}
+		mg.SetDeletionPolicy(p)
+		return mg
+	}
+
+	type want struct {
+		updated []metav1.OwnerReference
+		deleted bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      []APISecretPublisherOption
+		mg     resource.Managed
+		want   want
+	}{
+		"NotAligned": {
+			reason: "By default unpublishing should not touch the connection secret.",
+			mg:     managed(xpv1.DeletionDelete),
+		},
+		"AlignedOrphan": {
+			reason: "Our owner reference should be removed from the connection secret of an orphaned managed resource.",
+			o:      []APISecretPublisherOption{WithDeletionPolicyAlignment()},
+			mg:     managed(xpv1.DeletionOrphan),
+			want: want{
+				updated: []metav1.OwnerReference{other},
+			},
+		},
+		"AlignedDelete": {
+			reason: "The connection secret of a deleted managed resource should be deleted.",
+			o:      []APISecretPublisherOption{WithDeletionPolicyAlignment()},
+			mg:     managed(xpv1.DeletionDelete),
+			want: want{
+				deleted: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					secret().DeepCopyInto(obj.(*corev1.Secret))
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					got.updated = obj.GetOwnerReferences()
+					return nil
+				}),
+				MockDelete: test.NewMockDeleteFn(nil, func(_ client.Object) error {
+					got.deleted = true
+					return nil
+				}),
+			}
+			a := NewAPISecretPublisher(c, fake.SchemeWith(&fake.Managed{}), tc.o...)
+			if err := a.UnpublishConnection(context.Background(), tc.mg, ConnectionDetails{}); err != nil {
+				t.Fatalf("\n%s\nUnpublishConnection(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nUnpublishConnection(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type mockSimpleReferencer struct {
+	resource.Managed
+
+	MockResolveReferences func(context.Context, client.Reader) error `json:"-"`
+}
+
+func (r *mockSimpleReferencer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return r.MockResolveReferences(ctx, c)
+}
+
+func (r *mockSimpleReferencer) DeepCopyObject() runtime.Object {
+	return &mockSimpleReferencer{Managed: r.Managed.DeepCopyObject().(resource.Managed)}
+}
+
+func (r *mockSimpleReferencer) Equal(s *mockSimpleReferencer) bool {
+	return cmp.Equal(r.Managed, s.Managed)
+}
+
+func TestResolveReferences(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	different := &fake.Managed{}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		args   args
+		want   error
+	}{
+		"NoReferencersFound": {
+			reason: "Should return early without error when the managed resource has no references.",
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{},
+			},
+			want: nil,
+		},
+		"ResolveReferencesError": {
+			reason: "Should return errors encountered while resolving references.",
+			c: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &mockSimpleReferencer{
 					Managed: &fake.Managed{},
 					MockResolveReferences: func(context.Context, client.Reader) error {
 						return errBoom
@@ -355,6 +973,409 @@ func TestResolveReferences(t *testing.T) {
 	}
 }
 
+func TestResolveReferencesIfNotResolved(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	resolved := `{"generation":1,"references":[{"apiVersion":"v1","kind":"Secret","name":"cool-ref","generation":3}]}`
+	resolvedNamespaced := `{"generation":1,"references":[{"apiVersion":"v1","kind":"Secret","namespace":"cool","name":"cool-ref","generation":3}]}`
+	withGeneration := func(g int64) func(client.Object) error {
+		return func(obj client.Object) error {
+			obj.SetGeneration(g)
+			return nil
+		}
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+	type want struct {
+		err        error
+		annotation string
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		args   args
+		want   want
+	}{
+		"AlreadyResolved": {
+			reason: "Resolution should be skipped if the managed resource and the resources it references have not changed since its references were resolved.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withGeneration(3)),
+				MockScheme: test.NewMockSchemeFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &mockSimpleReferencer{
+					Managed: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+						Generation:  1,
+						Annotations: map[string]string{meta.AnnotationKeyResolvedReferences: resolved},
+					}},
+					MockResolveReferences: func(context.Context, client.Reader) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				annotation: resolved,
+			},
+		},
+		"RefCleared": {
+			reason: "Resolution should run if the managed resource's spec, for example a reference field, changed since its references were resolved.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withGeneration(3)),
+				MockScheme: test.NewMockSchemeFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &mockSimpleReferencer{
+					Managed: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+						Generation:  2,
+						Annotations: map[string]string{meta.AnnotationKeyResolvedReferences: resolved},
+					}},
+					MockResolveReferences: func(context.Context, client.Reader) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err:        errors.Wrap(errBoom, errResolveReferences),
+				annotation: resolved,
+			},
+		},
+		"ReferencedResourceChanged": {
+			reason: "Resolution should run if a referenced resource changed since references were resolved.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withGeneration(4)),
+				MockScheme: test.NewMockSchemeFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &mockSimpleReferencer{
+					Managed: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+						Generation:  1,
+						Annotations: map[string]string{meta.AnnotationKeyResolvedReferences: resolved},
+					}},
+					MockResolveReferences: func(context.Context, client.Reader) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err:        errors.Wrap(errBoom, errResolveReferences),
+				annotation: resolved,
+			},
+		},
+		"NamespacedReferencedResourceChanged": {
+			reason: "Resolution should run if a namespaced referenced resource changed since references were resolved, even if a resource with the same name in another namespace didn't.",
+			c: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					obj.SetGeneration(3)
+					if key.Namespace == "cool" {
+						obj.SetGeneration(4)
+					}
+					return nil
+				},
+				MockScheme: test.NewMockSchemeFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &mockSimpleReferencer{
+					Managed: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+						Generation:  1,
+						Annotations: map[string]string{meta.AnnotationKeyResolvedReferences: resolvedNamespaced},
+					}},
+					MockResolveReferences: func(context.Context, client.Reader) error {
+						return errBoom
+					},
+				},
+			},
+			want: want{
+				err:        errors.Wrap(errBoom, errResolveReferences),
+				annotation: resolvedNamespaced,
+			},
+		},
+		"RecordNamespacedResolvedReferences": {
+			reason: "The namespaces of the resources the managed resource referenced should be recorded after resolution.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withGeneration(3)),
+				MockPatch:  test.NewMockPatchFn(nil),
+				MockScheme: test.NewMockSchemeFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &mockSimpleReferencer{
+					Managed: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 1}},
+					MockResolveReferences: func(ctx context.Context, r client.Reader) error {
+						ref := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}}
+						return r.Get(ctx, types.NamespacedName{Namespace: "cool", Name: "cool-ref"}, ref)
+					},
+				},
+			},
+			want: want{
+				annotation: resolvedNamespaced,
+			},
+		},
+		"RecordResolvedReferences": {
+			reason: "The generations of the managed resource and the resources it referenced should be recorded after resolution.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withGeneration(3)),
+				MockPatch:  test.NewMockPatchFn(nil),
+				MockScheme: test.NewMockSchemeFn(nil),
+			},
+			args: args{
+				ctx: context.Background(),
+				mg: &mockSimpleReferencer{
+					Managed: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 1}},
+					MockResolveReferences: func(ctx context.Context, r client.Reader) error {
+						ref := &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}}
+						return r.Get(ctx, types.NamespacedName{Name: "cool-ref"}, ref)
+					},
+				},
+			},
+			want: want{
+				annotation: resolved,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewAPISimpleReferenceResolver(tc.c, WithResolutionPolicy(ReferenceResolutionIfNotResolved))
+			err := r.ResolveReferences(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.ResolveReferences(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			got := tc.args.mg.GetAnnotations()[meta.AnnotationKeyResolvedReferences]
+			if diff := cmp.Diff(tc.want.annotation, got); diff != "" {
+				t.Errorf("\n%s\nr.ResolveReferences(...): -want annotation, +got annotation:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type peeredManaged struct {
+	fake.Managed
+
+	Spec peeredManagedSpec `json:"spec"`
+}
+
+type peeredManagedSpec struct {
+	PeerID  string          `json:"peerID,omitempty"`
+	PeerRef *xpv1.Reference `json:"peerRef,omitempty"`
+}
+
+func (m *peeredManaged) DeepCopyObject() runtime.Object {
+	out := &peeredManaged{Managed: *m.Managed.DeepCopyObject().(*fake.Managed), Spec: m.Spec}
+	if m.Spec.PeerRef != nil {
+		out.Spec.PeerRef = m.Spec.PeerRef.DeepCopy()
+	}
+	return out
+}
+
+func (m *peeredManaged) ResolveReferences(ctx context.Context, c client.Reader) error {
+	rsp, err := reference.NewAPIResolver(c, m).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: m.Spec.PeerID,
+		Reference:    m.Spec.PeerRef,
+		To:           reference.To{Managed: &peeredManaged{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	m.Spec.PeerID = rsp.ResolvedValue
+	m.Spec.PeerRef = rsp.ResolvedReference
+	return nil
+}
+
+func TestResolveReferencesCycle(t *testing.T) {
+	a := &peeredManaged{
+		Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		Spec:    peeredManagedSpec{PeerRef: &xpv1.Reference{Name: "b"}},
+	}
+	b := &peeredManaged{
+		Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+		Spec:    peeredManagedSpec{PeerRef: &xpv1.Reference{Name: "a"}},
+	}
+	stored := map[string]*peeredManaged{"a": a, "b": b}
+
+	c := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			*obj.(*peeredManaged) = *stored[key.Name].DeepCopyObject().(*peeredManaged)
+			return nil
+		},
+		MockPatch:  test.NewMockPatchFn(nil),
+		MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&peeredManaged{})),
+	}
+	r := NewAPISimpleReferenceResolver(c, WithCycleDetection())
+
+	// Neither resource has an external name yet, so neither can resolve its
+	// reference to the other. The first resource to try can't tell that the
+	// other references it, but records that it read the other.
+	if err := r.ResolveReferences(context.Background(), a); err == nil || IsCyclicReference(err) {
+		t.Errorf("First ResolveReferences(a): want non-cyclic error, got: %v", err)
+	}
+	if err := r.ResolveReferences(context.Background(), b); !IsCyclicReference(err) {
+		t.Errorf("First ResolveReferences(b): want CyclicReferenceError, got: %v", err)
+	}
+
+	// Both resources proceed to create their external resources.
+	meta.SetExternalName(a, "id-a")
+	meta.SetExternalName(b, "id-b")
+
+	for _, mg := range []*peeredManaged{a, b} {
+		if err := r.ResolveReferences(context.Background(), mg); err != nil {
+			t.Errorf("Second ResolveReferences(%s): %v", mg.GetName(), err)
+		}
+		if diff := cmp.Diff("{}", mg.GetAnnotations()[meta.AnnotationKeyUnresolvedReferences]); diff != "" {
+			t.Errorf("Second ResolveReferences(%s): resolved references should record no unresolved references: -want, +got:\n%s", mg.GetName(), diff)
+		}
+	}
+
+	if diff := cmp.Diff("id-b", a.Spec.PeerID); diff != "" {
+		t.Errorf("a.Spec.PeerID: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("id-a", b.Spec.PeerID); diff != "" {
+		t.Errorf("b.Spec.PeerID: -want, +got:\n%s", diff)
+	}
+}
+
+func TestResolveReferencesNoCycle(t *testing.T) {
+	a := &peeredManaged{
+		Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		Spec:    peeredManagedSpec{PeerRef: &xpv1.Reference{Name: "b"}},
+	}
+	c := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			// b isn't ready, and read a resource named a that isn't a
+			// peeredManaged, so it doesn't reference a.
+			obj.SetName("b")
+			obj.SetAnnotations(map[string]string{meta.AnnotationKeyUnresolvedReferences: `{"references":[{"apiVersion":"v1","kind":"Secret","name":"a","generation":0}]}`})
+			return nil
+		},
+		MockPatch:  test.NewMockPatchFn(nil),
+		MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&peeredManaged{})),
+	}
+	r := NewAPISimpleReferenceResolver(c, WithCycleDetection())
+	err := r.ResolveReferences(context.Background(), a)
+	if err == nil || IsCyclicReference(err) {
+		t.Errorf("ResolveReferences(...): want non-cyclic error, got: %v", err)
+	}
+}
+
+func TestReferencesTo(t *testing.T) {
+	subnet := schema.GroupVersionKind{Group: "ec2.example.org", Version: "v1", Kind: "Subnet"}
+	obj := func(refs ...resolvedReferenceTo) client.Object {
+		u := &unstructured.Unstructured{Object: map[string]any{}}
+		v, _ := json.Marshal(unresolvedReferences{References: refs})
+		u.SetAnnotations(map[string]string{meta.AnnotationKeyUnresolvedReferences: string(v)})
+		return u
+	}
+
+	type args struct {
+		nn   types.NamespacedName
+		gvk  schema.GroupVersionKind
+		objs []client.Object
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"ReadResourceOfKind": {
+			reason: "A resource that read a resource of the supplied kind and name should reference it.",
+			args: args{
+				nn:   types.NamespacedName{Name: "a"},
+				gvk:  subnet,
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "ec2.example.org/v1", Kind: "Subnet", Name: "a"})},
+			},
+			want: true,
+		},
+		"ReadResourceOfOtherVersion": {
+			reason: "Resources should be matched regardless of their version.",
+			args: args{
+				nn:   types.NamespacedName{Name: "a"},
+				gvk:  subnet,
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "ec2.example.org/v1beta1", Kind: "Subnet", Name: "a"})},
+			},
+			want: true,
+		},
+		"ReadResourceOfOtherKind": {
+			reason: "A resource that read a resource of another kind shouldn't reference it, even if the name matches.",
+			args: args{
+				nn:   types.NamespacedName{Name: "a"},
+				gvk:  subnet,
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "ec2.example.org/v1", Kind: "VPC", Name: "a"})},
+			},
+			want: false,
+		},
+		"ReadResourceOfOtherGroup": {
+			reason: "A resource that read a resource of another group shouldn't reference it, even if the kind and name match.",
+			args: args{
+				nn:   types.NamespacedName{Name: "a"},
+				gvk:  subnet,
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "network.example.org/v1", Kind: "Subnet", Name: "a"})},
+			},
+			want: false,
+		},
+		"ReadResourceInOtherNamespace": {
+			reason: "A resource that read a resource with the same name in another namespace shouldn't reference it.",
+			args: args{
+				nn:   types.NamespacedName{Namespace: "cool", Name: "a"},
+				gvk:  subnet,
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "ec2.example.org/v1", Kind: "Subnet", Namespace: "lame", Name: "a"})},
+			},
+			want: false,
+		},
+		"ReadResourceInSameNamespace": {
+			reason: "A resource that read a resource of the supplied kind, namespace, and name should reference it.",
+			args: args{
+				nn:   types.NamespacedName{Namespace: "cool", Name: "a"},
+				gvk:  subnet,
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "ec2.example.org/v1", Kind: "Subnet", Namespace: "cool", Name: "a"})},
+			},
+			want: true,
+		},
+		"UnknownKind": {
+			reason: "Any resource with the supplied name should be considered if the kind is unknown.",
+			args: args{
+				nn:   types.NamespacedName{Name: "a"},
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "ec2.example.org/v1", Kind: "VPC", Name: "a"})},
+			},
+			want: true,
+		},
+		"OtherName": {
+			reason: "A resource that read a resource with another name shouldn't reference it.",
+			args: args{
+				nn:   types.NamespacedName{Name: "a"},
+				gvk:  subnet,
+				objs: []client.Object{obj(resolvedReferenceTo{APIVersion: "ec2.example.org/v1", Kind: "Subnet", Name: "b"})},
+			},
+			want: false,
+		},
+		"NotRecorded": {
+			reason: "A resource that didn't record the resources it read shouldn't reference anything.",
+			args: args{
+				nn:   types.NamespacedName{Name: "a"},
+				objs: []client.Object{&unstructured.Unstructured{Object: map[string]any{}}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := referencesTo(tc.args.nn, tc.args.gvk, tc.args.objs...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nreferencesTo(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestPrepareJSONMerge(t *testing.T) {
 	type args struct {
 		existing runtime.Object
@@ -504,3 +1525,199 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchingCriticalAnnotationUpdater(t *testing.T) {
+	errBoom := errors.New("boom")
+	errConflict := kerrors.NewConflict(schema.GroupResource{}, "cool", errBoom)
+
+	type want struct {
+		err   error
+		patch string
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"PatchError": {
+			reason: "We should return any error we encounter patching the supplied object without retrying errors that aren't transient.",
+			err:    errBoom,
+			want: want{
+				err:   errors.Wrap(errBoom, errPatchCriticalAnnotations),
+				patch: `{"metadata":{"annotations":{"crossplane.io/external-name":"cool"}}}`,
+				calls: 1,
+			},
+		},
+		"TransientPatchError": {
+			reason: "We should retry transient errors we encounter patching the supplied object.",
+			err:    errConflict,
+			want: want{
+				err:   errors.Wrap(errConflict, errPatchCriticalAnnotations),
+				patch: `{"metadata":{"annotations":{"crossplane.io/external-name":"cool"}}}`,
+				calls: retry.DefaultRetry.Steps,
+			},
+		},
+		"Success": {
+			reason: "The patch should contain only the annotations of the supplied object.",
+			want: want{
+				patch: `{"metadata":{"annotations":{"crossplane.io/external-name":"cool"}}}`,
+				calls: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockPatch: func(_ context.Context, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+					b, err := patch.Data(obj)
+					if err != nil {
+						return err
+					}
+					got.patch = string(b)
+					got.calls++
+					return tc.err
+				},
+			}
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"cool": "true"}}}
+			meta.SetExternalName(mg, "cool")
+
+			u := NewPatchingCriticalAnnotationUpdater(c)
+			got.err = u.UpdateCriticalAnnotations(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nu.UpdateCriticalAnnotations(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAPIPatchingManagedUpdater(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		original *fake.Managed
+		mg       *fake.Managed
+	}
+	type want struct {
+		err   error
+		patch string
+	}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		args   args
+		want   want
+	}{
+		"PatchError": {
+			reason: "We should return any error we encounter patching the supplied managed resource.",
+			err:    errBoom,
+			args: args{
+				original: &fake.Managed{},
+				mg:       &fake.Managed{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"cool": "true"}}},
+			},
+			want: want{
+				err:   errBoom,
+				patch: `{"labels":{"cool":"true"}}`,
+			},
+		},
+		"OnlyChangedFields": {
+			reason: "The patch should contain only the fields that changed since the original state.",
+			args: args{
+				original: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+					Name:   "cool",
+					Labels: map[string]string{"cool": "true"},
+				}},
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+					Name:        "cool",
+					Labels:      map[string]string{"cool": "true"},
+					Annotations: map[string]string{meta.AnnotationKeyExternalName: "cool"},
+				}},
+			},
+			want: want{
+				patch: `{"annotations":{"crossplane.io/external-name":"cool"}}`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				// Note that fake.Managed serializes its metadata inline.
+				MockPatch: func(_ context.Context, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+					b, err := patch.Data(obj)
+					if err != nil {
+						return err
+					}
+					got.patch = string(b)
+					return tc.err
+				},
+			}
+			u := NewAPIPatchingManagedUpdater(c)
+			got.err = u.UpdateManaged(context.Background(), tc.args.original, tc.args.mg)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nu.UpdateManaged(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConditionPreservingManagedUpdater(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// An updater that responds like the API server would, with its own view of
+	// the managed resource's status.
+	reset := ManagedUpdaterFn(func(_ context.Context, _, mg resource.Managed) error {
+		mg.(*fake.Managed).ConditionedStatus = xpv1.ConditionedStatus{}
+		mg.SetConditions(xpv1.Creating())
+		return nil
+	})
+
+	type want struct {
+		err        error
+		conditions []xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		u      ManagedUpdater
+		want   want
+	}{
+		"UpdateError": {
+			reason: "We should return any error we encounter updating the managed resource.",
+			u: ManagedUpdaterFn(func(_ context.Context, _, _ resource.Managed) error {
+				return errBoom
+			}),
+			want: want{
+				err:        errBoom,
+				conditions: []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()},
+			},
+		},
+		"ConditionsRestored": {
+			reason: "Conditions set before the update should be restored, replacing those returned by the update.",
+			u:      reset,
+			want: want{
+				conditions: []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+
+			err := NewConditionPreservingManagedUpdater(tc.u).UpdateManaged(context.Background(), &fake.Managed{}, mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nu.UpdateManaged(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, mg.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nu.UpdateManaged(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -26,12 +26,15 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/apis/changelogs/proto/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
-	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
 const (
 	defaultSendTimeout = 10 * time.Second
+
+	// keyControllerID is the log key, event annotation, and change log
+	// additional detail used to identify the controller instance.
+	keyControllerID = "controller-id"
 )
 
 // ChangeLogger is an interface for recording changes made to resources to the
@@ -46,6 +49,7 @@ type GRPCChangeLogger struct {
 	client          v1alpha1.ChangeLogServiceClient
 	providerVersion string
 	sendTimeout     time.Duration
+	filter          ChangeLogFilter
 }
 
 // NewGRPCChangeLogger creates a new gRPC based ChangeLogger initialized with
@@ -82,8 +86,26 @@ func WithSendTimeout(timeout time.Duration) GRPCChangeLoggerOption {
 	}
 }
 
-// Log sends the given change log entry to the change log service.
+// A ChangeLogFilter returns true if a change log entry should be recorded for
+// the supplied operation on the supplied managed resource.
+type ChangeLogFilter func(opType v1alpha1.OperationType, managed resource.Managed) bool
+
+// WithChangeLogFilter sets a filter that determines which operations generate
+// change log entries. Entries the filter rejects are not sent to the change log
+// service. By default entries are recorded for all operations.
+func WithChangeLogFilter(f ChangeLogFilter) GRPCChangeLoggerOption {
+	return func(g *GRPCChangeLogger) {
+		g.filter = f
+	}
+}
+
+// Log sends the given change log entry to the change log service, unless it is
+// rejected by the change log filter.
 func (g *GRPCChangeLogger) Log(ctx context.Context, managed resource.Managed, opType v1alpha1.OperationType, changeErr error, ad AdditionalDetails) error {
+	if g.filter != nil && !g.filter(opType, managed) {
+		return nil
+	}
+
 	// get an error message from the error if it exists
 	var changeErrMessage *string
 	if changeErr != nil {
@@ -104,7 +126,7 @@ func (g *GRPCChangeLogger) Log(ctx context.Context, managed resource.Managed, op
 		ApiVersion:        gvk.GroupVersion().String(),
 		Kind:              gvk.Kind,
 		Name:              managed.GetName(),
-		ExternalName:      meta.GetExternalName(managed),
+		ExternalName:      resource.GetExternalName(managed),
 		Operation:         opType,
 		Snapshot:          snapshot,
 		ErrorMessage:      changeErrMessage,
@@ -133,3 +155,20 @@ func newNopChangeLogger() *nopChangeLogger {
 func (n *nopChangeLogger) Log(_ context.Context, _ resource.Managed, _ v1alpha1.OperationType, _ error, _ AdditionalDetails) error {
 	return nil
 }
+
+// identifiedChangeLogger adds the ID of the controller instance to the
+// additional details of every change log entry before passing it to the
+// wrapped ChangeLogger.
+type identifiedChangeLogger struct {
+	wrapped ChangeLogger
+	id      string
+}
+
+func (i *identifiedChangeLogger) Log(ctx context.Context, managed resource.Managed, opType v1alpha1.OperationType, changeErr error, ad AdditionalDetails) error {
+	withID := make(AdditionalDetails, len(ad)+1)
+	for k, v := range ad {
+		withID[k] = v
+	}
+	withID[keyControllerID] = i.id
+	return i.wrapped.Log(ctx, managed, opType, changeErr, withID)
+}
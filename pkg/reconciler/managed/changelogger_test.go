@@ -195,3 +195,50 @@ func equateApproxTimepb(margin time.Duration) []cmp.Option {
 func msgIsTimestamp(x reflect.Value) bool {
 	return x.Interface().(protocmp.Message).Descriptor().FullName() == "google.protobuf.Timestamp"
 }
+
+func TestChangeLoggerFilter(t *testing.T) {
+	// Only record Create and Delete entries.
+	filter := func(op v1alpha1.OperationType, _ resource.Managed) bool {
+		return op != v1alpha1.OperationType_OPERATION_TYPE_UPDATE
+	}
+
+	cases := map[string]struct {
+		reason string
+		op     v1alpha1.OperationType
+		want   int
+	}{
+		"Create": {
+			reason: "Create entries should be sent if the filter allows them.",
+			op:     v1alpha1.OperationType_OPERATION_TYPE_CREATE,
+			want:   1,
+		},
+		"Update": {
+			reason: "Update entries should not be sent if the filter rejects them.",
+			op:     v1alpha1.OperationType_OPERATION_TYPE_UPDATE,
+			want:   0,
+		},
+		"Delete": {
+			reason: "Delete entries should be sent if the filter allows them.",
+			op:     v1alpha1.OperationType_OPERATION_TYPE_DELETE,
+			want:   1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &changeLogServiceClient{}
+			change := NewGRPCChangeLogger(c, WithChangeLogFilter(filter))
+			if err := change.Log(context.Background(), &fake.Managed{}, tc.op, nil, nil); err != nil {
+				t.Fatalf("\nReason: %s\nchange.Log(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, len(c.requests)); diff != "" {
+				t.Errorf("\nReason: %s\nchange.Log(...): -want requests, +got requests:\n%s", tc.reason, diff)
+			}
+			for _, r := range c.requests {
+				if r.GetEntry().GetOperation() != tc.op {
+					t.Errorf("\nReason: %s\nchange.Log(...): want operation %s, got %s", tc.reason, tc.op, r.GetEntry().GetOperation())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	defaultAIMDMinDelay      = 1 * time.Second
+	defaultAIMDMaxDelay      = 1 * time.Minute
+	defaultAIMDDecreaseDelay = 500 * time.Millisecond
+)
+
+// A ConcurrencyController adapts how quickly the Reconciler calls an external
+// system, for example in response to the external system throttling it.
+type ConcurrencyController interface {
+	// Delay returns how long the Reconciler should wait before it reconciles
+	// a managed resource.
+	Delay() time.Duration
+
+	// Observe the result of a call to the external system. The supplied
+	// error is nil if the call succeeded.
+	Observe(err error)
+}
+
+type nopConcurrencyController struct{}
+
+func (nopConcurrencyController) Delay() time.Duration { return 0 }
+func (nopConcurrencyController) Observe(_ error)      {}
+
+// WithAdaptiveConcurrency configures the Reconciler to report the result of
+// each call to the external system to the supplied ConcurrencyController, and
+// to wait for the delay it returns before reconciling each managed resource.
+// Delaying reconciles reduces the effective parallelism of the Reconciler. By
+// default reconciles are never delayed.
+func WithAdaptiveConcurrency(cc ConcurrencyController) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.concurrency = cc
+	}
+}
+
+// An AIMDConcurrencyControllerOption configures an AIMDConcurrencyController.
+type AIMDConcurrencyControllerOption func(*AIMDConcurrencyController)
+
+// WithAIMDDelayBounds configures the delay an AIMDConcurrencyController
+// introduces when the external system first throttles the Reconciler, and the
+// maximum delay it introduces if throttling persists.
+func WithAIMDDelayBounds(minDelay, maxDelay time.Duration) AIMDConcurrencyControllerOption {
+	return func(c *AIMDConcurrencyController) {
+		c.min = minDelay
+		c.max = maxDelay
+	}
+}
+
+// WithAIMDDecrease configures how much an AIMDConcurrencyController decreases
+// its delay each time a call to the external system succeeds.
+func WithAIMDDecrease(d time.Duration) AIMDConcurrencyControllerOption {
+	return func(c *AIMDConcurrencyController) {
+		c.decrease = d
+	}
+}
+
+// An AIMDConcurrencyController adapts to throttling using an additive increase,
+// multiplicative decrease (AIMD) strategy. Each time the external system
+// throttles the Reconciler the controller doubles its delay, multiplicatively
+// decreasing parallelism. Each time a call to the external system succeeds it
+// decreases its delay by a fixed amount, additively increasing parallelism.
+// Errors that aren't throttling errors don't change the delay.
+type AIMDConcurrencyController struct {
+	min      time.Duration
+	max      time.Duration
+	decrease time.Duration
+
+	mu    sync.Mutex
+	delay time.Duration
+}
+
+// NewAIMDConcurrencyController returns a ConcurrencyController that adapts to
+// throttling using an additive increase, multiplicative decrease strategy.
+func NewAIMDConcurrencyController(o ...AIMDConcurrencyControllerOption) *AIMDConcurrencyController {
+	c := &AIMDConcurrencyController{
+		min:      defaultAIMDMinDelay,
+		max:      defaultAIMDMaxDelay,
+		decrease: defaultAIMDDecreaseDelay,
+	}
+	for _, fn := range o {
+		fn(c)
+	}
+	return c
+}
+
+// Delay returns the current delay.
+func (c *AIMDConcurrencyController) Delay() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.delay
+}
+
+// Observe the result of a call to the external system.
+func (c *AIMDConcurrencyController) Observe(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case errors.IsThrottled(err):
+		c.delay = min(max(c.delay*2, c.min), c.max)
+	case err == nil:
+		c.delay = max(c.delay-c.decrease, 0)
+	}
+}
+
+// waitForConcurrency waits for the delay returned by the Reconciler's
+// ConcurrencyController, if any. It returns early if the supplied context is
+// done.
+func (r *Reconciler) waitForConcurrency(ctx context.Context) {
+	d := r.concurrency.Delay()
+	if d <= 0 {
+		return
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
+
+// A concurrencyObservingExternalClient reports the result of each call to an
+// ExternalClient to a ConcurrencyController.
+type concurrencyObservingExternalClient struct {
+	ExternalClient
+
+	cc ConcurrencyController
+}
+
+func (c *concurrencyObservingExternalClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	c.cc.Observe(err)
+	return o, err
+}
+
+func (c *concurrencyObservingExternalClient) Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.cc.Observe(err)
+	return cr, err
+}
+
+func (c *concurrencyObservingExternalClient) Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error) {
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.cc.Observe(err)
+	return u, err
+}
+
+func (c *concurrencyObservingExternalClient) Delete(ctx context.Context, mg resource.Managed) (ExternalDelete, error) {
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.cc.Observe(err)
+	return d, err
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var _ ConcurrencyController = &AIMDConcurrencyController{}
+
+func TestAIMDConcurrencyController(t *testing.T) {
+	errThrottled := kerrors.NewTooManyRequests("slow down", 1)
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason   string
+		o        []AIMDConcurrencyControllerOption
+		observed []error
+		want     []time.Duration
+	}{
+		"RepeatedThrottling": {
+			reason:   "Repeated throttling should multiplicatively increase the delay, up to the maximum.",
+			o:        []AIMDConcurrencyControllerOption{WithAIMDDelayBounds(time.Second, 5*time.Second)},
+			observed: []error{errThrottled, errThrottled, errThrottled, errThrottled},
+			want:     []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second},
+		},
+		"SuccessesDecay": {
+			reason:   "Successes should additively decrease the delay, down to zero.",
+			o:        []AIMDConcurrencyControllerOption{WithAIMDDelayBounds(time.Second, time.Minute), WithAIMDDecrease(750 * time.Millisecond)},
+			observed: []error{errThrottled, errThrottled, nil, nil, nil, nil},
+			want:     []time.Duration{time.Second, 2 * time.Second, 1250 * time.Millisecond, 500 * time.Millisecond, 0, 0},
+		},
+		"OtherErrors": {
+			reason:   "Errors that aren't throttling errors should not change the delay.",
+			observed: []error{errThrottled, errBoom, errors.Wrap(errThrottled, "cannot observe")},
+			want:     []time.Duration{defaultAIMDMinDelay, defaultAIMDMinDelay, 2 * defaultAIMDMinDelay},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewAIMDConcurrencyController(tc.o...)
+			got := make([]time.Duration, 0, len(tc.observed))
+			for _, err := range tc.observed {
+				c.Observe(err)
+				got = append(got, c.Delay())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDelay(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type fakeConcurrencyController struct {
+	delay    time.Duration
+	observed []error
+}
+
+func (c *fakeConcurrencyController) Delay() time.Duration { return c.delay }
+func (c *fakeConcurrencyController) Observe(err error)    { c.observed = append(c.observed, err) }
+
+func TestReconcilerAdaptiveConcurrency(t *testing.T) {
+	errThrottled := kerrors.NewTooManyRequests("slow down", 1)
+
+	cc := &fakeConcurrencyController{delay: time.Millisecond}
+	r := NewReconciler(&fake.Manager{
+		Client: &test.MockClient{
+			MockGet:          test.NewMockGetFn(nil),
+			MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.Managed{}),
+	}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithAdaptiveConcurrency(cc),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{}, errThrottled
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+
+	want := []error{errThrottled}
+	if diff := cmp.Diff(want, cc.observed, test.EquateErrors()); diff != "" {
+		t.Errorf("\nThe result of each call to the external client should be reported to the ConcurrencyController.\nObserve(...): -want, +got:\n%s", diff)
+	}
+}
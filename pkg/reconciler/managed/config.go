@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// A ReconcilerConfig configures the Reconciler options that are typically
+// shared by all of a provider's managed resource controllers. Providers may
+// build a ReconcilerConfig once and reuse it for each kind they reconcile.
+type ReconcilerConfig struct {
+	// Logger the Reconciler should use.
+	Logger logging.Logger
+
+	// Recorder the Reconciler should use to record events.
+	Recorder event.Recorder
+
+	// PollInterval at which the Reconciler should poll external resources.
+	PollInterval time.Duration
+
+	// PollJitter is added to or subtracted from the PollInterval.
+	PollJitter time.Duration
+
+	// Timeout for all calls made during a single reconcile.
+	Timeout time.Duration
+
+	// Features that should be enabled.
+	Features *feature.Flags
+
+	// MetricRecorder the Reconciler should use to record metrics.
+	MetricRecorder MetricRecorder
+
+	// ChangeLogger the Reconciler should use to record change logs. It's only
+	// used if the alpha change logs feature is enabled.
+	ChangeLogger ChangeLogger
+}
+
+// ReconcilerOptions returns the ReconcilerOptions that correspond to this
+// config. Fields that are not set are omitted, so the Reconciler's defaults
+// apply. The returned options may be followed by kind-specific options, for
+// example WithExternalConnecter.
+func (c ReconcilerConfig) ReconcilerOptions() []ReconcilerOption {
+	o := make([]ReconcilerOption, 0)
+	if c.Logger != nil {
+		o = append(o, WithLogger(c.Logger))
+	}
+	if c.Recorder != nil {
+		o = append(o, WithRecorder(c.Recorder))
+	}
+	if c.PollInterval != 0 {
+		o = append(o, WithPollInterval(c.PollInterval))
+	}
+	if c.PollJitter != 0 {
+		o = append(o, WithPollJitterHook(c.PollJitter))
+	}
+	if c.Timeout != 0 {
+		o = append(o, WithTimeout(c.Timeout))
+	}
+	if c.Features.Enabled(feature.EnableBetaManagementPolicies) {
+		o = append(o, WithManagementPolicies())
+	}
+	if c.MetricRecorder != nil {
+		o = append(o, WithMetricRecorder(c.MetricRecorder))
+	}
+	if c.ChangeLogger != nil && c.Features.Enabled(feature.EnableAlphaChangeLogs) {
+		o = append(o, WithChangeLogger(c.ChangeLogger))
+	}
+	return o
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestReconcilerConfig(t *testing.T) {
+	log := logging.NewLogrLogger(logr.Discard())
+	rec := event.NewAPIRecorder(nil)
+	mr := NewMRMetricRecorder()
+	cl := NewGRPCChangeLogger(nil)
+
+	f := &feature.Flags{}
+	f.Enable(feature.EnableBetaManagementPolicies)
+	f.Enable(feature.EnableAlphaChangeLogs)
+
+	// We compare the types of the configured logger, recorder, etc. Each
+	// type we configure differs from the Reconciler's default.
+	typeOf := func(v any) string { return fmt.Sprintf("%T", v) }
+
+	type want struct {
+		Log                string
+		Record             string
+		PollInterval       time.Duration
+		Timeout            time.Duration
+		ManagementPolicies bool
+		MetricRecorder     string
+		Change             string
+	}
+
+	defaults := want{
+		Log:            typeOf(logging.NewNopLogger()),
+		Record:         typeOf(event.NewNopRecorder()),
+		PollInterval:   defaultPollInterval,
+		Timeout:        reconcileTimeout,
+		MetricRecorder: typeOf(NewNopMetricRecorder()),
+		Change:         typeOf(newNopChangeLogger()),
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      ReconcilerConfig
+		want   want
+	}{
+		"Empty": {
+			reason: "An empty config should leave the Reconciler's defaults in place.",
+			c:      ReconcilerConfig{},
+			want:   defaults,
+		},
+		"Full": {
+			reason: "Each configured field should set the corresponding Reconciler field.",
+			c: ReconcilerConfig{
+				Logger:         log,
+				Recorder:       rec,
+				PollInterval:   42 * time.Second,
+				Timeout:        7 * time.Second,
+				Features:       f,
+				MetricRecorder: mr,
+				ChangeLogger:   cl,
+			},
+			want: want{
+				Log:                typeOf(log),
+				Record:             typeOf(rec),
+				PollInterval:       42 * time.Second,
+				Timeout:            7 * time.Second,
+				ManagementPolicies: true,
+				MetricRecorder:     typeOf(mr),
+				Change:             typeOf(cl),
+			},
+		},
+		"ChangeLogsDisabled": {
+			reason: "The change logger should only be used if the change logs feature is enabled.",
+			c: ReconcilerConfig{
+				ChangeLogger: cl,
+			},
+			want: defaults,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &fake.Manager{Client: &test.MockClient{}, Scheme: fake.SchemeWith(&fake.Managed{})}
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.Managed{})), tc.c.ReconcilerOptions()...)
+
+			got := want{
+				Log:                typeOf(r.log),
+				Record:             typeOf(r.record),
+				PollInterval:       r.pollInterval,
+				Timeout:            r.timeout,
+				ManagementPolicies: r.features.Enabled(feature.EnableBetaManagementPolicies),
+				MetricRecorder:     typeOf(r.metricRecorder),
+				Change:             typeOf(r.change),
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nReconcilerOptions(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
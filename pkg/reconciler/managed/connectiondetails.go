@@ -0,0 +1,237 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errGetConnectionDetailsRef = "cannot get resource referenced for connection details"
+	errGetReferencedSecret     = "cannot get connection secret of referenced resource"
+
+	errFmtNoReferencedSecret = "referenced %s %q does not publish a connection secret"
+	errFmtMissingSecretKey   = "connection secret of referenced %s %q has no key %q"
+)
+
+// A ConnectionDetailsReference refers to a resource whose published connection
+// details should be made available while reconciling the referencing resource.
+type ConnectionDetailsReference struct {
+	// APIVersion of the referenced resource.
+	APIVersion string
+
+	// Kind of the referenced resource.
+	Kind string
+
+	// Name of the referenced resource.
+	Name string
+
+	// Namespace of the referenced resource, if it is namespaced.
+	Namespace string
+
+	// Keys of the referenced resource's connection details to propagate. All
+	// keys are propagated if none are specified.
+	Keys []string
+}
+
+// A ConnectionDetailsReferencer references the connection details of other
+// resources.
+type ConnectionDetailsReferencer interface {
+	GetConnectionDetailsReferences() []ConnectionDetailsReference
+}
+
+// A ConnectionDetailsResolver resolves the connection details a managed
+// resource references.
+type ConnectionDetailsResolver interface {
+	// ResolveConnectionDetails returns the merged connection details
+	// referenced by the supplied managed resource. It returns an error if any
+	// referenced connection details are not yet available.
+	ResolveConnectionDetails(ctx context.Context, mg resource.Managed) (ConnectionDetails, error)
+}
+
+// A ConnectionDetailsResolverFn is a function that satisfies the
+// ConnectionDetailsResolver interface.
+type ConnectionDetailsResolverFn func(ctx context.Context, mg resource.Managed) (ConnectionDetails, error)
+
+// ResolveConnectionDetails calls ConnectionDetailsResolverFn function.
+func (fn ConnectionDetailsResolverFn) ResolveConnectionDetails(ctx context.Context, mg resource.Managed) (ConnectionDetails, error) {
+	return fn(ctx, mg)
+}
+
+// An APIConnectionDetailsResolver resolves connection details by reading the
+// connection secrets published by the resources a managed resource references.
+type APIConnectionDetailsResolver struct {
+	client client.Reader
+}
+
+// NewAPIConnectionDetailsResolver returns a ConnectionDetailsResolver that
+// reads connection details from the connection secrets of the resources
+// referenced by managed resources that satisfy ConnectionDetailsReferencer.
+func NewAPIConnectionDetailsResolver(c client.Reader) *APIConnectionDetailsResolver {
+	return &APIConnectionDetailsResolver{client: c}
+}
+
+// ResolveConnectionDetails reads the connection secret of each resource
+// referenced by the supplied managed resource and merges the selected keys.
+// When two references select the same key the latter takes precedence. A
+// managed resource that doesn't satisfy ConnectionDetailsReferencer has no
+// referenced connection details.
+func (r *APIConnectionDetailsResolver) ResolveConnectionDetails(ctx context.Context, mg resource.Managed) (ConnectionDetails, error) {
+	cdr, ok := mg.(ConnectionDetailsReferencer)
+	if !ok {
+		return nil, nil
+	}
+
+	var cd ConnectionDetails
+	for _, ref := range cdr.GetConnectionDetailsReferences() {
+		u := &kunstructured.Unstructured{}
+		u.SetAPIVersion(ref.APIVersion)
+		u.SetKind(ref.Kind)
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, u); err != nil {
+			return nil, errors.Wrap(err, errGetConnectionDetailsRef)
+		}
+
+		sr := &xpv1.SecretReference{}
+		if err := fieldpath.Pave(u.Object).GetValueInto("spec.writeConnectionSecretToRef", sr); err != nil || sr.Name == "" {
+			return nil, errors.Errorf(errFmtNoReferencedSecret, ref.Kind, ref.Name)
+		}
+		if sr.Namespace == "" {
+			sr.Namespace = ref.Namespace
+		}
+
+		s := &corev1.Secret{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: sr.Namespace, Name: sr.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetReferencedSecret)
+		}
+
+		if cd == nil {
+			cd = ConnectionDetails{}
+		}
+		if len(ref.Keys) == 0 {
+			for k, v := range s.Data {
+				cd[k] = v
+			}
+			continue
+		}
+		for _, k := range ref.Keys {
+			v, ok := s.Data[k]
+			if !ok {
+				return nil, errors.Errorf(errFmtMissingSecretKey, ref.Kind, ref.Name, k)
+			}
+			cd[k] = v
+		}
+	}
+	return cd, nil
+}
+
+type referencedConnectionDetailsKey struct{}
+
+func withReferencedConnectionDetails(ctx context.Context, cd ConnectionDetails) context.Context {
+	return context.WithValue(ctx, referencedConnectionDetailsKey{}, cd)
+}
+
+// ReferencedConnectionDetailsFrom returns the connection details resolved from
+// the resources referenced by the managed resource being reconciled, if any.
+// The managed reconciler makes them available to the context passed to an
+// ExternalConnecter and the ExternalClient it returns.
+func ReferencedConnectionDetailsFrom(ctx context.Context) ConnectionDetails {
+	cd, _ := ctx.Value(referencedConnectionDetailsKey{}).(ConnectionDetails)
+	return cd
+}
+
+// A ConnectionDetailsMergePolicy determines how the Reconciler merges the
+// connection details returned by Observe with those returned by a subsequent
+// Create or Update before it publishes them. Merging happens within a single
+// reconcile; connection details aren't remembered across reconciles.
+type ConnectionDetailsMergePolicy string
+
+// Connection details merge policies.
+const (
+	// ConnectionDetailsLastWins prefers the connection details returned by
+	// Create or Update to those returned by Observe.
+	ConnectionDetailsLastWins ConnectionDetailsMergePolicy = "LastWins"
+
+	// ConnectionDetailsObserveWins prefers the connection details returned by
+	// Observe to those returned by Create or Update.
+	ConnectionDetailsObserveWins ConnectionDetailsMergePolicy = "ObserveWins"
+)
+
+// WithConnectionDetailsMergePolicy configures the Reconciler to merge the
+// connection details returned by Observe with those returned by Create or
+// Update per the supplied policy, and to publish the merged connection
+// details. This prevents published connection details from flapping when
+// Observe and Create or Update return different values for the same key. By
+// default the connection details returned by each call are published as is.
+func WithConnectionDetailsMergePolicy(p ConnectionDetailsMergePolicy) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.connectionDetailsMerge = p
+	}
+}
+
+// merge the supplied observed connection details with the supplied connection
+// details returned by a subsequent Create or Update.
+func (p ConnectionDetailsMergePolicy) merge(observed, later ConnectionDetails) ConnectionDetails {
+	if p == "" {
+		return later
+	}
+	first, second := observed, later
+	if p == ConnectionDetailsObserveWins {
+		first, second = later, observed
+	}
+	merged := make(ConnectionDetails, len(observed)+len(later))
+	for k, v := range first {
+		merged[k] = v
+	}
+	for k, v := range second {
+		merged[k] = v
+	}
+	return merged
+}
+
+// WithObservationConnectionDetailsOnly configures the Reconciler to publish
+// only the connection details returned by Observe. The connection details
+// returned by Create and Update are ignored, and aren't published. This avoids
+// redundant publishing for providers that return complete connection details
+// from Observe.
+func WithObservationConnectionDetailsOnly() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.observationConnectionDetailsOnly = true
+	}
+}
+
+// publishOperationConnection publishes the supplied connection details returned
+// by a Create or Update, merged with the supplied observed connection details
+// per the Reconciler's merge policy. Nothing is published if the Reconciler
+// publishes only the connection details returned by Observe.
+func (r *Reconciler) publishOperationConnection(ctx context.Context, mg resource.Managed, observed, later ConnectionDetails) error {
+	if r.observationConnectionDetailsOnly {
+		return nil
+	}
+	_, err := r.publishConnection(ctx, mg, r.connectionDetailsMerge.merge(observed, later))
+	return err
+}
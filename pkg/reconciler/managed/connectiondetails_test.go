@@ -0,0 +1,473 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type connectionDetailsReferencer struct {
+	fake.Managed
+	refs []ConnectionDetailsReference
+}
+
+func (m *connectionDetailsReferencer) GetConnectionDetailsReferences() []ConnectionDetailsReference {
+	return m.refs
+}
+
+// referencedSecrets returns a MockGetFn that returns a referenced resource
+// publishing to a secret of the same name, and the supplied secrets.
+func referencedSecrets(secrets map[string]map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		switch o := obj.(type) {
+		case *kunstructured.Unstructured:
+			o.Object["spec"] = map[string]any{
+				"writeConnectionSecretToRef": map[string]any{"name": key.Name, "namespace": "cool-namespace"},
+			}
+			return nil
+		case *corev1.Secret:
+			data, ok := secrets[key.Name]
+			if !ok {
+				return kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+			}
+			o.Data = data
+			return nil
+		}
+		return errors.New("unexpected object")
+	}
+}
+
+func TestAPIConnectionDetailsResolver(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	secrets := map[string]map[string][]byte{
+		"db":    {"endpoint": []byte("db.example.org"), "password": []byte("secret")},
+		"cache": {"endpoint": []byte("cache.example.org"), "port": []byte("6379")},
+	}
+
+	type args struct {
+		c  client.Reader
+		mg resource.Managed
+	}
+	type want struct {
+		cd  ConnectionDetails
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotAReferencer": {
+			reason: "A managed resource that doesn't reference connection details should have none.",
+			args: args{
+				c:  &test.MockClient{},
+				mg: &fake.Managed{},
+			},
+			want: want{},
+		},
+		"Merge": {
+			reason: "Selected keys of each referenced connection secret should be merged, with later references taking precedence.",
+			args: args{
+				c: &test.MockClient{MockGet: referencedSecrets(secrets)},
+				mg: &connectionDetailsReferencer{refs: []ConnectionDetailsReference{
+					{APIVersion: "example.org/v1", Kind: "Database", Name: "db"},
+					{APIVersion: "example.org/v1", Kind: "Cache", Name: "cache", Keys: []string{"endpoint"}},
+				}},
+			},
+			want: want{
+				cd: ConnectionDetails{
+					"endpoint": []byte("cache.example.org"),
+					"password": []byte("secret"),
+				},
+			},
+		},
+		"MissingSecret": {
+			reason: "A referenced resource that hasn't published its connection secret yet should block resolution.",
+			args: args{
+				c: &test.MockClient{MockGet: referencedSecrets(secrets)},
+				mg: &connectionDetailsReferencer{refs: []ConnectionDetailsReference{
+					{APIVersion: "example.org/v1", Kind: "Bucket", Name: "bucket"},
+				}},
+			},
+			want: want{
+				err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "bucket"), errGetReferencedSecret),
+			},
+		},
+		"MissingKey": {
+			reason: "A selected key that isn't in the referenced connection secret should block resolution.",
+			args: args{
+				c: &test.MockClient{MockGet: referencedSecrets(secrets)},
+				mg: &connectionDetailsReferencer{refs: []ConnectionDetailsReference{
+					{APIVersion: "example.org/v1", Kind: "Cache", Name: "cache", Keys: []string{"password"}},
+				}},
+			},
+			want: want{
+				err: errors.Errorf(errFmtMissingSecretKey, "Cache", "cache", "password"),
+			},
+		},
+		"NoConnectionSecret": {
+			reason: "A referenced resource that doesn't publish a connection secret should block resolution.",
+			args: args{
+				c: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				mg: &connectionDetailsReferencer{refs: []ConnectionDetailsReference{
+					{APIVersion: "example.org/v1", Kind: "Database", Name: "db"},
+				}},
+			},
+			want: want{
+				err: errors.Errorf(errFmtNoReferencedSecret, "Database", "db"),
+			},
+		},
+		"GetReferencedResourceError": {
+			reason: "Errors getting the referenced resource should be returned.",
+			args: args{
+				c: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				mg: &connectionDetailsReferencer{refs: []ConnectionDetailsReference{
+					{APIVersion: "example.org/v1", Kind: "Database", Name: "db"},
+				}},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetConnectionDetailsRef),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewAPIConnectionDetailsResolver(tc.args.c)
+			cd, err := r.ResolveConnectionDetails(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.ResolveConnectionDetails(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cd, cd); diff != "" {
+				t.Errorf("\n%s\nr.ResolveConnectionDetails(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerConnectionDetailsResolver(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		connected bool
+		cd        ConnectionDetails
+		deleteCD  ConnectionDetails
+		deleted   bool
+		cond      xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason  string
+		cr      ConnectionDetailsResolver
+		deleted bool
+		want    want
+	}{
+		"Resolved": {
+			reason: "Resolved connection details should be available to the ExternalConnecter.",
+			cr: ConnectionDetailsResolverFn(func(_ context.Context, _ resource.Managed) (ConnectionDetails, error) {
+				return ConnectionDetails{"endpoint": []byte("db.example.org")}, nil
+			}),
+			want: want{
+				connected: true,
+				cd:        ConnectionDetails{"endpoint": []byte("db.example.org")},
+				cond:      xpv1.ReconcileSuccess(),
+			},
+		},
+		"Unresolved": {
+			reason: "Unresolved connection details should block reconciliation like an unresolved reference.",
+			cr: ConnectionDetailsResolverFn(func(_ context.Context, _ resource.Managed) (ConnectionDetails, error) {
+				return nil, errBoom
+			}),
+			want: want{
+				cond: xpv1.ReconcileError(errors.Wrap(errBoom, errResolveConnectionDetails)),
+			},
+		},
+		"ResolvedWhileDeleting": {
+			reason: "Resolved connection details should be available to the ExternalClient when deleting the external resource.",
+			cr: ConnectionDetailsResolverFn(func(_ context.Context, _ resource.Managed) (ConnectionDetails, error) {
+				return ConnectionDetails{"endpoint": []byte("db.example.org")}, nil
+			}),
+			deleted: true,
+			want: want{
+				connected: true,
+				cd:        ConnectionDetails{"endpoint": []byte("db.example.org")},
+				deleteCD:  ConnectionDetails{"endpoint": []byte("db.example.org")},
+				deleted:   true,
+				cond:      xpv1.ReconcileSuccess(),
+			},
+		},
+		"UnresolvedWhileDeleting": {
+			reason: "Unresolved connection details shouldn't block deleting the external resource.",
+			cr: ConnectionDetailsResolverFn(func(_ context.Context, _ resource.Managed) (ConnectionDetails, error) {
+				return nil, errBoom
+			}),
+			deleted: true,
+			want: want{
+				connected: true,
+				deleted:   true,
+				cond:      xpv1.ReconcileSuccess(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					if tc.deleted {
+						obj.(*fake.Managed).SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+						obj.(*fake.Managed).SetDeletionPolicy(xpv1.DeletionDelete)
+					}
+					return nil
+				}),
+				MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					got.cond = obj.(*fake.Managed).GetCondition(xpv1.TypeSynced)
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithConnectionDetailsResolver(tc.cr),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(ctx context.Context, _ resource.Managed) (ExternalClient, error) {
+					got.connected = true
+					got.cd = ReferencedConnectionDetailsFrom(ctx)
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DeleteFn: func(ctx context.Context, _ resource.Managed) (ExternalDelete, error) {
+							got.deleted = true
+							got.deleteCD = ReferencedConnectionDetailsFrom(ctx)
+							return ExternalDelete{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerConnectionDetailsMergePolicy(t *testing.T) {
+	observed := ConnectionDetails{"endpoint": []byte("observed.example.org"), "port": []byte("5432")}
+	later := ConnectionDetails{"endpoint": []byte("later.example.org"), "password": []byte("hunter2")}
+
+	type args struct {
+		exists bool
+		o      []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   ConnectionDetails
+	}{
+		"NoMergePolicy": {
+			reason: "Without a merge policy the connection details returned by Update should be published as is.",
+			args:   args{exists: true},
+			want:   later,
+		},
+		"LastWinsUpdate": {
+			reason: "Overlapping connection details returned by Update should win when the last wins.",
+			args:   args{exists: true, o: []ReconcilerOption{WithConnectionDetailsMergePolicy(ConnectionDetailsLastWins)}},
+			want: ConnectionDetails{
+				"endpoint": []byte("later.example.org"),
+				"port":     []byte("5432"),
+				"password": []byte("hunter2"),
+			},
+		},
+		"ObserveWinsUpdate": {
+			reason: "Overlapping connection details returned by Observe should win when Observe wins.",
+			args:   args{exists: true, o: []ReconcilerOption{WithConnectionDetailsMergePolicy(ConnectionDetailsObserveWins)}},
+			want: ConnectionDetails{
+				"endpoint": []byte("observed.example.org"),
+				"port":     []byte("5432"),
+				"password": []byte("hunter2"),
+			},
+		},
+		"LastWinsCreate": {
+			reason: "Overlapping connection details returned by Create should win when the last wins.",
+			args:   args{o: []ReconcilerOption{WithConnectionDetailsMergePolicy(ConnectionDetailsLastWins)}},
+			want: ConnectionDetails{
+				"endpoint": []byte("later.example.org"),
+				"port":     []byte("5432"),
+				"password": []byte("hunter2"),
+			},
+		},
+		"ObserveWinsCreate": {
+			reason: "Overlapping connection details returned by Observe should win over those returned by Create when Observe wins.",
+			args:   args{o: []ReconcilerOption{WithConnectionDetailsMergePolicy(ConnectionDetailsObserveWins)}},
+			want: ConnectionDetails{
+				"endpoint": []byte("observed.example.org"),
+				"port":     []byte("5432"),
+				"password": []byte("hunter2"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got ConnectionDetails
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: tc.args.exists, ResourceUpToDate: false, ConnectionDetails: observed}, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{ConnectionDetails: later}, nil
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{ConnectionDetails: later}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, cd ConnectionDetails) (bool, error) {
+						got = cd
+						return true, nil
+					},
+				}),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want published, +got published:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerObservationConnectionDetailsOnly(t *testing.T) {
+	observed := ConnectionDetails{"endpoint": []byte("observed.example.org")}
+	later := ConnectionDetails{"password": []byte("hunter2")}
+
+	type args struct {
+		exists bool
+		o      []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []ConnectionDetails
+	}{
+		"Create": {
+			reason: "By default the connection details returned by Create should be published after those returned by Observe.",
+			args:   args{},
+			want:   []ConnectionDetails{observed, later},
+		},
+		"CreateObservationOnly": {
+			reason: "The connection details returned by Create shouldn't be published when only observed connection details are published.",
+			args:   args{o: []ReconcilerOption{WithObservationConnectionDetailsOnly()}},
+			want:   []ConnectionDetails{observed},
+		},
+		"Update": {
+			reason: "By default the connection details returned by Update should be published after those returned by Observe.",
+			args:   args{exists: true},
+			want:   []ConnectionDetails{observed, later},
+		},
+		"UpdateObservationOnly": {
+			reason: "The connection details returned by Update shouldn't be published when only observed connection details are published.",
+			args:   args{exists: true, o: []ReconcilerOption{WithObservationConnectionDetailsOnly()}},
+			want:   []ConnectionDetails{observed},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []ConnectionDetails
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: tc.args.exists, ResourceUpToDate: false, ConnectionDetails: observed}, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{ConnectionDetails: later}, nil
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{ConnectionDetails: later}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, cd ConnectionDetails) (bool, error) {
+						got = append(got, cd)
+						return true, nil
+					},
+				}),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want published, +got published:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
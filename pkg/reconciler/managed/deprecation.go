@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const reasonDeprecatedField event.Reason = "DeprecatedField"
+
+// TypeDeprecatedFields managed resources set fields that are deprecated, and
+// will be removed in a future version of their API. The Reconciler only sets
+// this condition if it's configured with a DeprecatedFieldWarner.
+const TypeDeprecatedFields xpv1.ConditionType = "DeprecatedFields"
+
+// Reasons a managed resource does or does not set deprecated fields.
+const (
+	ReasonDeprecatedFieldsInUse   xpv1.ConditionReason = "DeprecatedFieldsInUse"
+	ReasonNoDeprecatedFieldsInUse xpv1.ConditionReason = "NoDeprecatedFieldsInUse"
+)
+
+// DeprecatedFieldsInUse returns a condition that indicates a managed resource
+// sets the supplied deprecated fields.
+func DeprecatedFieldsInUse(fields ...resource.DeprecatedField) xpv1.Condition {
+	msgs := make([]string, len(fields))
+	for i, f := range fields {
+		msgs[i] = deprecatedFieldMessage(f)
+	}
+	return xpv1.Condition{
+		Type:               TypeDeprecatedFields,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeprecatedFieldsInUse,
+		Message:            strings.Join(msgs, "; "),
+	}
+}
+
+// NoDeprecatedFieldsInUse returns a condition that indicates a managed resource
+// doesn't set any deprecated fields.
+func NoDeprecatedFieldsInUse() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeprecatedFields,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoDeprecatedFieldsInUse,
+	}
+}
+
+func deprecatedFieldMessage(f resource.DeprecatedField) string {
+	msg := f.Path + " is deprecated and will be removed"
+	if f.Message != "" {
+		msg += ": " + f.Message
+	}
+	return msg
+}
+
+// A DeprecatedFieldWarner is an Initializer that warns when a managed resource
+// sets deprecated fields. It emits a DeprecatedField event for each deprecated
+// field the managed resource sets, and sets its DeprecatedFields condition.
+// The condition is set in memory, and persisted when the Reconciler updates
+// the managed resource's status. Initializers that update the managed
+// resource reset its status, so a DeprecatedFieldWarner should be configured
+// after them.
+type DeprecatedFieldWarner struct {
+	fields []resource.DeprecatedField
+	record event.Recorder
+}
+
+// NewDeprecatedFieldWarner returns a new DeprecatedFieldWarner that warns
+// about the supplied deprecated fields using the supplied event recorder.
+func NewDeprecatedFieldWarner(r event.Recorder, fields ...resource.DeprecatedField) *DeprecatedFieldWarner {
+	return &DeprecatedFieldWarner{fields: fields, record: r}
+}
+
+// Initialize the given managed resource by warning about any deprecated
+// fields it sets. If it doesn't set any its DeprecatedFields condition is set
+// to False, but only if it previously set deprecated fields.
+func (w *DeprecatedFieldWarner) Initialize(_ context.Context, mg resource.Managed) error {
+	inUse, err := resource.DeprecatedFieldsInUse(mg, w.fields...)
+	if err != nil {
+		return err
+	}
+	if len(inUse) == 0 {
+		if mg.GetCondition(TypeDeprecatedFields).Status == corev1.ConditionTrue {
+			mg.SetConditions(NoDeprecatedFieldsInUse())
+		}
+		return nil
+	}
+	for _, f := range inUse {
+		w.record.Event(mg, event.Warning(reasonDeprecatedField, errors.New(deprecatedFieldMessage(f))))
+	}
+	mg.SetConditions(DeprecatedFieldsInUse(inUse...))
+	return nil
+}
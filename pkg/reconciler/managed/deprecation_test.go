@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type deprecatingSpec struct {
+	Region   string `json:"region,omitempty"`
+	Location string `json:"location,omitempty"`
+}
+
+type deprecatingManaged struct {
+	fake.Managed
+
+	Spec deprecatingSpec `json:"spec"`
+}
+
+func TestDeprecatedFieldWarner(t *testing.T) {
+	region := resource.DeprecatedField{Path: "spec.region", Message: "use spec.location instead"}
+
+	type want struct {
+		reasons []event.Reason
+		cond    xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *deprecatingManaged
+		want   want
+	}{
+		"DeprecatedFieldSet": {
+			reason: "We should emit an event and set a condition explaining that a deprecated field is set.",
+			mg:     &deprecatingManaged{Spec: deprecatingSpec{Region: "us-east-1"}},
+			want: want{
+				reasons: []event.Reason{reasonDeprecatedField},
+				cond:    DeprecatedFieldsInUse(region),
+			},
+		},
+		"DeprecatedFieldNotSet": {
+			reason: "We shouldn't warn or set a condition if no deprecated fields are set.",
+			mg:     &deprecatingManaged{Spec: deprecatingSpec{Location: "us-east-1"}},
+			want: want{
+				cond: xpv1.Condition{Type: TypeDeprecatedFields, Status: corev1.ConditionUnknown},
+			},
+		},
+		"DeprecatedFieldNoLongerSet": {
+			reason: "We should set the condition to False if deprecated fields are no longer set.",
+			mg: func() *deprecatingManaged {
+				mg := &deprecatingManaged{Spec: deprecatingSpec{Location: "us-east-1"}}
+				mg.SetConditions(DeprecatedFieldsInUse(region))
+				return mg
+			}(),
+			want: want{
+				cond: NoDeprecatedFieldsInUse(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &reasonRecorder{}
+			w := NewDeprecatedFieldWarner(rec, region)
+			if err := w.Initialize(context.Background(), tc.mg); err != nil {
+				t.Fatalf("\n%s\nw.Initialize(...): unexpected error: %s", tc.reason, err)
+			}
+			got := want{reasons: rec.reasons, cond: tc.mg.GetCondition(TypeDeprecatedFields)}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nw.Initialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeprecatedFieldsInUseCondition(t *testing.T) {
+	got := DeprecatedFieldsInUse(
+		resource.DeprecatedField{Path: "spec.region", Message: "use spec.location instead"},
+		resource.DeprecatedField{Path: "spec.zone"},
+	).Message
+	want := "spec.region is deprecated and will be removed: use spec.location instead; spec.zone is deprecated and will be removed"
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DeprecatedFieldsInUse(...): -want message, +got message:\n%s", diff)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides fake implementations of the clients used by the
+// managed resource reconciler, for use in tests.
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/crossplane/crossplane-runtime/apis/changelogs/proto/v1alpha1"
+)
+
+var _ v1alpha1.ChangeLogServiceClient = &ChangeLogServiceClient{}
+
+// A ChangeLogServiceClient is an in-memory ChangeLogServiceClient that records
+// the requests it receives. Its zero value is ready to use, and is safe for
+// concurrent use.
+type ChangeLogServiceClient struct {
+	// MockSendChangeLog is called, if set, after a request is recorded. Its
+	// return values are returned by SendChangeLog. Use it to simulate
+	// failures to send a change log entry.
+	MockSendChangeLog func(ctx context.Context, in *v1alpha1.SendChangeLogRequest, opts ...grpc.CallOption) (*v1alpha1.SendChangeLogResponse, error)
+
+	mu       sync.Mutex
+	requests []*v1alpha1.SendChangeLogRequest
+}
+
+// SendChangeLog records the supplied request, then calls MockSendChangeLog if
+// it is set.
+func (c *ChangeLogServiceClient) SendChangeLog(ctx context.Context, in *v1alpha1.SendChangeLogRequest, opts ...grpc.CallOption) (*v1alpha1.SendChangeLogResponse, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, in)
+	c.mu.Unlock()
+
+	if c.MockSendChangeLog != nil {
+		return c.MockSendChangeLog(ctx, in, opts...)
+	}
+	return &v1alpha1.SendChangeLogResponse{}, nil
+}
+
+// Requests returns the requests received, in the order they were received.
+func (c *ChangeLogServiceClient) Requests() []*v1alpha1.SendChangeLogRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]*v1alpha1.SendChangeLogRequest, len(c.requests))
+	copy(out, c.requests)
+	return out
+}
+
+// Entries returns the change log entries received, in the order they were
+// received.
+func (c *ChangeLogServiceClient) Entries() []*v1alpha1.ChangeLogEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]*v1alpha1.ChangeLogEntry, 0, len(c.requests))
+	for _, r := range c.requests {
+		out = append(out, r.GetEntry())
+	}
+	return out
+}
+
+// Reset forgets all requests received.
+func (c *ChangeLogServiceClient) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"k8s.io/utils/ptr"
+
+	"github.com/crossplane/crossplane-runtime/apis/changelogs/proto/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestChangeLogServiceClient(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		send   func(ctx context.Context, in *v1alpha1.SendChangeLogRequest, opts ...grpc.CallOption) (*v1alpha1.SendChangeLogResponse, error)
+		op     v1alpha1.OperationType
+		logErr error
+	}
+	type want struct {
+		op     v1alpha1.OperationType
+		errMsg *string
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"RecordsEntry": {
+			reason: "The operation type of a change log entry should be recorded.",
+			args: args{
+				op: v1alpha1.OperationType_OPERATION_TYPE_UPDATE,
+			},
+			want: want{
+				op: v1alpha1.OperationType_OPERATION_TYPE_UPDATE,
+			},
+		},
+		"RecordsErrorMessage": {
+			reason: "The error message of a change log entry should be recorded.",
+			args: args{
+				op:     v1alpha1.OperationType_OPERATION_TYPE_CREATE,
+				logErr: errBoom,
+			},
+			want: want{
+				op:     v1alpha1.OperationType_OPERATION_TYPE_CREATE,
+				errMsg: ptr.To("boom"),
+			},
+		},
+		"SendFailure": {
+			reason: "A change log entry should be recorded even if MockSendChangeLog returns an error.",
+			args: args{
+				send: func(_ context.Context, _ *v1alpha1.SendChangeLogRequest, _ ...grpc.CallOption) (*v1alpha1.SendChangeLogResponse, error) {
+					return nil, errBoom
+				},
+				op: v1alpha1.OperationType_OPERATION_TYPE_DELETE,
+			},
+			want: want{
+				op:  v1alpha1.OperationType_OPERATION_TYPE_DELETE,
+				err: errors.Wrap(errBoom, "cannot send change log entry"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &ChangeLogServiceClient{MockSendChangeLog: tc.args.send}
+			l := managed.NewGRPCChangeLogger(c)
+			err := l.Log(context.Background(), &fake.Managed{}, tc.args.op, tc.args.logErr, nil)
+
+			if len(c.Requests()) != 1 {
+				t.Fatalf("\n%s\nc.Requests(): want 1 request, got %d", tc.reason, len(c.Requests()))
+			}
+			e := c.Entries()[0]
+			got := want{op: e.GetOperation(), errMsg: e.ErrorMessage, err: err}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nl.Log(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			c.Reset()
+			if len(c.Entries()) != 0 {
+				t.Errorf("\n%s\nc.Reset(): want no entries after reset, got %d", tc.reason, len(c.Entries()))
+			}
+		})
+	}
+}
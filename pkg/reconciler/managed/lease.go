@@ -0,0 +1,200 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// The default time a lease is held before it expires. Leases are released
+	// when a reconcile finishes, so this only matters if a replica stops
+	// before it can release its lease. It exceeds the default reconcile
+	// timeout, but not necessarily one configured using WithTimeout.
+	defaultLeaseDuration = reconcileTimeout + reconcileGracePeriod
+
+	// How long to wait for a lease to be released once a reconcile finishes.
+	leaseReleaseTimeout = 10 * time.Second
+
+	// How long to wait before trying to reconcile a managed resource whose
+	// lease is held by another replica.
+	defaultLeaseRetryInterval = 10 * time.Second
+)
+
+// Error strings.
+const (
+	errGetLease     = "cannot get lease"
+	errCreateLease  = "cannot create lease"
+	errUpdateLease  = "cannot update lease"
+	errReleaseLease = "cannot release lease"
+)
+
+// A LeaseManager coordinates reconciles of a managed resource between several
+// replicas of a controller by granting a lease on the resource to one replica
+// at a time.
+type LeaseManager interface {
+	// Acquire a lease on the supplied managed resource. It returns false if
+	// the lease is held by another replica.
+	Acquire(ctx context.Context, mg resource.Managed) (bool, error)
+
+	// Release a lease on the supplied managed resource.
+	Release(ctx context.Context, mg resource.Managed) error
+}
+
+// A NopLeaseManager always acquires leases. It's used by Reconcilers that
+// don't need to coordinate with other replicas.
+type NopLeaseManager struct{}
+
+// Acquire always returns true.
+func (m NopLeaseManager) Acquire(_ context.Context, _ resource.Managed) (bool, error) {
+	return true, nil
+}
+
+// Release does nothing.
+func (m NopLeaseManager) Release(_ context.Context, _ resource.Managed) error {
+	return nil
+}
+
+// An APILeaseManagerOption configures an APILeaseManager.
+type APILeaseManagerOption func(*APILeaseManager)
+
+// WithLeaseDuration configures how long a lease is held before it expires, if
+// it is not released. The duration must exceed the reconcile timeout of any
+// Reconciler that uses the APILeaseManager, including its grace period. If it
+// doesn't, a lease may expire and be acquired by another replica while a
+// reconcile is still running. The default duration exceeds the default
+// reconcile timeout, so it must be increased if WithTimeout is used to
+// increase the reconcile timeout.
+func WithLeaseDuration(d time.Duration) APILeaseManagerOption {
+	return func(m *APILeaseManager) {
+		m.duration = d
+	}
+}
+
+// WithLeaseClock configures the clock used to determine when leases are
+// acquired, renewed, and expire. The real clock is used by default.
+func WithLeaseClock(c clock.Clock) APILeaseManagerOption {
+	return func(m *APILeaseManager) {
+		m.clock = c
+	}
+}
+
+// An APILeaseManager grants leases on managed resources using
+// coordination.k8s.io Lease objects. Each Lease is named for the UID of the
+// managed resource it leases.
+type APILeaseManager struct {
+	client    client.Client
+	namespace string
+	identity  string
+	duration  time.Duration
+	clock     clock.Clock
+}
+
+// NewAPILeaseManager returns a LeaseManager that grants leases to the supplied
+// identity, which should be unique to each replica of a controller, using
+// Lease objects in the supplied namespace.
+func NewAPILeaseManager(c client.Client, namespace, identity string, o ...APILeaseManagerOption) *APILeaseManager {
+	m := &APILeaseManager{client: c, namespace: namespace, identity: identity, duration: defaultLeaseDuration, clock: clock.RealClock{}}
+	for _, fn := range o {
+		fn(m)
+	}
+	return m
+}
+
+// Acquire a lease on the supplied managed resource. Leases that are held by
+// another replica but have expired are taken over.
+func (m *APILeaseManager) Acquire(ctx context.Context, mg resource.Managed) (bool, error) {
+	now := metav1.NewMicroTime(m.clock.Now())
+
+	l := &coordinationv1.Lease{}
+	err := m.client.Get(ctx, types.NamespacedName{Namespace: m.namespace, Name: string(mg.GetUID())}, l)
+	if kerrors.IsNotFound(err) {
+		l = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: m.namespace, Name: string(mg.GetUID())},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(m.identity),
+				LeaseDurationSeconds: ptr.To(int32(m.duration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		err := m.client.Create(ctx, l)
+		if kerrors.IsAlreadyExists(err) {
+			// Another replica created the lease before us.
+			return false, nil
+		}
+		return err == nil, errors.Wrap(err, errCreateLease)
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetLease)
+	}
+
+	if held(l, m.identity, now.Time) {
+		return false, nil
+	}
+
+	if ptr.Deref(l.Spec.HolderIdentity, "") != m.identity {
+		l.Spec.AcquireTime = &now
+	}
+	l.Spec.HolderIdentity = ptr.To(m.identity)
+	l.Spec.LeaseDurationSeconds = ptr.To(int32(m.duration.Seconds()))
+	l.Spec.RenewTime = &now
+
+	// The update will fail with a conflict if another replica acquired the
+	// lease since we read it.
+	err = m.client.Update(ctx, l)
+	if kerrors.IsConflict(err) {
+		return false, nil
+	}
+	return err == nil, errors.Wrap(err, errUpdateLease)
+}
+
+// held returns true if the supplied lease is held by a holder other than the
+// supplied identity, and has not expired.
+func held(l *coordinationv1.Lease, identity string, now time.Time) bool {
+	holder := ptr.Deref(l.Spec.HolderIdentity, "")
+	if holder == "" || holder == identity || l.Spec.RenewTime == nil {
+		return false
+	}
+	expires := l.Spec.RenewTime.Add(time.Duration(ptr.Deref(l.Spec.LeaseDurationSeconds, 0)) * time.Second)
+	return now.Before(expires)
+}
+
+// Release a lease on the supplied managed resource, if it's held by this
+// replica.
+func (m *APILeaseManager) Release(ctx context.Context, mg resource.Managed) error {
+	l := &coordinationv1.Lease{}
+	if err := m.client.Get(ctx, types.NamespacedName{Namespace: m.namespace, Name: string(mg.GetUID())}, l); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetLease)
+	}
+	if ptr.Deref(l.Spec.HolderIdentity, "") != m.identity {
+		return nil
+	}
+	return errors.Wrap(resource.IgnoreNotFound(m.client.Delete(ctx, l, client.Preconditions{ResourceVersion: ptr.To(l.GetResourceVersion())})), errReleaseLease)
+}
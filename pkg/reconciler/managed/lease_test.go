@@ -0,0 +1,242 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var _ LeaseManager = NopLeaseManager{}
+var _ LeaseManager = &APILeaseManager{}
+
+func withLease(holder string, renewed time.Time) func(client.Object) error {
+	return func(obj client.Object) error {
+		l := obj.(*coordinationv1.Lease)
+		l.Spec.HolderIdentity = ptr.To(holder)
+		l.Spec.LeaseDurationSeconds = ptr.To(int32(60))
+		l.Spec.RenewTime = &metav1.MicroTime{Time: renewed}
+		return nil
+	}
+}
+
+func TestAPILeaseManagerAcquire(t *testing.T) {
+	errBoom := errors.New("boom")
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: types.UID("cool-uid")}}
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		acquired bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"GetError": {
+			reason: "Errors getting the lease should be returned.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			mg:   mg,
+			want: want{err: errors.Wrap(errBoom, errGetLease)},
+		},
+		"NotFound": {
+			reason: "We should create and acquire a lease that does not exist.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+					l := obj.(*coordinationv1.Lease)
+					if l.GetName() != string(mg.GetUID()) {
+						t.Errorf("Create(...): want lease named %q, got %q", mg.GetUID(), l.GetName())
+					}
+					if diff := cmp.Diff(ptr.To("me"), l.Spec.HolderIdentity); diff != "" {
+						t.Errorf("Create(...): -want holder, +got holder:\n%s", diff)
+					}
+					return nil
+				}),
+			},
+			mg:   mg,
+			want: want{acquired: true},
+		},
+		"CreatedByAnother": {
+			reason: "We should not acquire a lease that another replica created before us.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				MockCreate: test.NewMockCreateFn(kerrors.NewAlreadyExists(schema.GroupResource{}, "")),
+			},
+			mg:   mg,
+			want: want{acquired: false},
+		},
+		"CreateError": {
+			reason: "Errors creating the lease should be returned.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				MockCreate: test.NewMockCreateFn(errBoom),
+			},
+			mg:   mg,
+			want: want{err: errors.Wrap(errBoom, errCreateLease)},
+		},
+		"HeldByAnother": {
+			reason: "We should not acquire a lease that is held by another replica and has not expired.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, withLease("you", now)),
+			},
+			mg:   mg,
+			want: want{acquired: false},
+		},
+		"ExpiredHeldByAnother": {
+			reason: "We should take over a lease that is held by another replica but has expired.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, withLease("you", now.Add(-2*time.Minute))),
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					l := obj.(*coordinationv1.Lease)
+					if diff := cmp.Diff(ptr.To("me"), l.Spec.HolderIdentity); diff != "" {
+						t.Errorf("Update(...): -want holder, +got holder:\n%s", diff)
+					}
+					return nil
+				}),
+			},
+			mg:   mg,
+			want: want{acquired: true},
+		},
+		"HeldByUs": {
+			reason: "We should renew a lease that we already hold.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withLease("me", now)),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg:   mg,
+			want: want{acquired: true},
+		},
+		"UpdateConflict": {
+			reason: "We should not acquire a lease that another replica acquired since we read it.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withLease("you", now.Add(-2*time.Minute))),
+				MockUpdate: test.NewMockUpdateFn(kerrors.NewConflict(schema.GroupResource{}, "", errBoom)),
+			},
+			mg:   mg,
+			want: want{acquired: false},
+		},
+		"UpdateError": {
+			reason: "Errors updating the lease should be returned.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withLease("you", now.Add(-2*time.Minute))),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			mg:   mg,
+			want: want{err: errors.Wrap(errBoom, errUpdateLease)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := NewAPILeaseManager(tc.c, "cool-namespace", "me", WithLeaseClock(testingclock.NewFakeClock(now)))
+			acquired, err := m.Acquire(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want.acquired, acquired); diff != "" {
+				t.Errorf("\n%s\nm.Acquire(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nm.Acquire(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAPILeaseManagerRelease(t *testing.T) {
+	errBoom := errors.New("boom")
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: types.UID("cool-uid")}}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		mg     resource.Managed
+		want   error
+	}{
+		"NotFound": {
+			reason: "We should return early if the lease does not exist.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+			},
+			mg: mg,
+		},
+		"GetError": {
+			reason: "Errors getting the lease should be returned.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			mg:   mg,
+			want: errors.Wrap(errBoom, errGetLease),
+		},
+		"HeldByAnother": {
+			reason: "We should not delete a lease that is held by another replica.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withLease("you", time.Now())),
+				MockDelete: test.NewMockDeleteFn(errBoom),
+			},
+			mg: mg,
+		},
+		"HeldByUs": {
+			reason: "We should delete a lease that we hold.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withLease("me", time.Now())),
+				MockDelete: test.NewMockDeleteFn(nil),
+			},
+			mg: mg,
+		},
+		"DeleteError": {
+			reason: "Errors deleting the lease should be returned.",
+			c: &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, withLease("me", time.Now())),
+				MockDelete: test.NewMockDeleteFn(errBoom),
+			},
+			mg:   mg,
+			want: errors.Wrap(errBoom, errReleaseLease),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := NewAPILeaseManager(tc.c, "cool-namespace", "me")
+			err := m.Release(context.Background(), tc.mg)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nm.Release(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package managedtest provides utilities for testing the full lifecycle of a
+// managed resource reconciler against an in-memory external system.
+package managedtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// An Operation the managed reconciler performed against an ExternalSystem.
+type Operation string
+
+// Operations the managed reconciler may perform against an ExternalSystem.
+const (
+	OperationObserve Operation = "Observe"
+	OperationCreate  Operation = "Create"
+	OperationUpdate  Operation = "Update"
+	OperationDelete  Operation = "Delete"
+)
+
+// A DesiredStateFn returns the state an external resource should have per the
+// supplied managed resource. The returned state is compared to the stored
+// state using cmp.Equal to determine whether the external resource is up to
+// date.
+type DesiredStateFn func(mg resource.Managed) any
+
+// An ExternalSystem is an in-memory model of an external system. It stores
+// external resources keyed by external name, and records the operations the
+// managed reconciler performs against them.
+type ExternalSystem struct {
+	desired DesiredStateFn
+
+	mu        sync.Mutex
+	resources map[string]any
+	calls     []Operation
+}
+
+// NewExternalSystem returns an empty ExternalSystem that derives the desired
+// state of each external resource from its managed resource using the supplied
+// function.
+func NewExternalSystem(desired DesiredStateFn) *ExternalSystem {
+	return &ExternalSystem{desired: desired, resources: map[string]any{}}
+}
+
+// Get returns the stored state of the external resource with the supplied
+// external name, and whether it exists.
+func (e *ExternalSystem) Get(name string) (any, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	s, ok := e.resources[name]
+	return s, ok
+}
+
+// Set the stored state of the external resource with the supplied external
+// name, for example to simulate drift.
+func (e *ExternalSystem) Set(name string, state any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.resources[name] = state
+}
+
+// Calls returns the operations performed against the ExternalSystem, in order.
+func (e *ExternalSystem) Calls() []Operation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]Operation(nil), e.calls...)
+}
+
+// ResetCalls forgets all recorded operations.
+func (e *ExternalSystem) ResetCalls() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = nil
+}
+
+// Connect returns an ExternalClient for the ExternalSystem. It satisfies the
+// managed.ExternalConnecter interface.
+func (e *ExternalSystem) Connect(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+	return &managed.ExternalClientFns{
+		ObserveFn:    e.observe,
+		CreateFn:     e.create,
+		UpdateFn:     e.update,
+		DeleteFn:     e.delete,
+		DisconnectFn: func(_ context.Context) error { return nil },
+	}, nil
+}
+
+func (e *ExternalSystem) observe(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, OperationObserve)
+
+	s, ok := e.resources[resource.GetExternalName(mg)]
+	if !ok {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cmp.Equal(s, e.desired(mg)),
+	}, nil
+}
+
+func (e *ExternalSystem) create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, OperationCreate)
+
+	if resource.GetExternalName(mg) == "" {
+		resource.SetExternalName(mg, mg.GetName())
+	}
+	e.resources[resource.GetExternalName(mg)] = e.desired(mg)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *ExternalSystem) update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, OperationUpdate)
+
+	e.resources[resource.GetExternalName(mg)] = e.desired(mg)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *ExternalSystem) delete(_ context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.calls = append(e.calls, OperationDelete)
+
+	delete(e.resources, resource.GetExternalName(mg))
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedtest
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// Error strings.
+const (
+	errFmtNotStable  = "managed resource did not become stable after %d reconciles"
+	errFmtNotManaged = "cannot store %T: not a managed resource"
+)
+
+// A Harness drives a managed resource reconciler through multiple reconciles
+// of a single managed resource. The managed resource is stored in memory in
+// place of the API server, and its external resource is stored in an
+// ExternalSystem.
+type Harness struct {
+	external   *ExternalSystem
+	reconciler *managed.Reconciler
+	name       types.NamespacedName
+
+	mu      sync.Mutex
+	managed resource.Managed
+	gone    bool
+}
+
+// NewHarness returns a Harness that reconciles the supplied managed resource.
+// The desired state of its external resource is derived using the supplied
+// function. By default the reconciler connects to the Harness's
+// ExternalSystem, and doesn't initialize managed resources, resolve
+// references, or publish connection details. Any supplied options are applied
+// after these defaults, and may override them.
+func NewHarness(mg resource.Managed, desired DesiredStateFn, o ...managed.ReconcilerOption) *Harness {
+	h := &Harness{
+		external: NewExternalSystem(desired),
+		name:     types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()},
+		managed:  mg.DeepCopyObject().(resource.Managed), //nolint:forcetypeassert // A deep copy of a managed resource is a managed resource.
+	}
+
+	c := &test.MockClient{
+		MockGet:    h.get,
+		MockUpdate: h.update,
+		MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			return h.store(obj)
+		},
+	}
+	m := &fake.Manager{
+		Client: c,
+		Scheme: fake.SchemeWith(mg),
+	}
+
+	ro := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(h.external),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		managed.WithConnectionPublishers(),
+		managed.WithCreationGracePeriod(0),
+	}
+	h.reconciler = managed.NewReconciler(m, resource.ManagedKind(fake.GVK(mg)), append(ro, o...)...)
+	return h
+}
+
+// External returns the ExternalSystem that stores the external resource.
+func (h *Harness) External() *ExternalSystem {
+	return h.external
+}
+
+// Managed returns a copy of the stored managed resource. It returns nil once
+// the managed resource has been deleted.
+func (h *Harness) Managed() resource.Managed {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.gone {
+		return nil
+	}
+	return h.managed.DeepCopyObject().(resource.Managed) //nolint:forcetypeassert // A deep copy of a managed resource is a managed resource.
+}
+
+// Update the stored managed resource using the supplied function, for example
+// to change its desired state.
+func (h *Harness) Update(fn func(mg resource.Managed)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	fn(h.managed)
+}
+
+// Delete the stored managed resource. Like the API server the Harness only
+// marks the managed resource for deletion, and forgets it once all its
+// finalizers have been removed.
+func (h *Harness) Delete() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := metav1.NewTime(time.Now())
+	h.managed.SetDeletionTimestamp(&now)
+	h.forgetIfFinalized()
+}
+
+// Reconcile the managed resource once.
+func (h *Harness) Reconcile(ctx context.Context) (reconcile.Result, error) {
+	return h.reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: h.name})
+}
+
+// ReconcileUntilStable reconciles the managed resource until the reconciler no
+// longer asks to be requeued immediately, or the managed resource is deleted.
+// It returns an error if reconciling fails, or if the managed resource isn't
+// stable after the supplied number of reconciles.
+func (h *Harness) ReconcileUntilStable(ctx context.Context, maxReconciles int) error {
+	for range maxReconciles {
+		result, err := h.Reconcile(ctx)
+		if err != nil {
+			return err
+		}
+		if !result.Requeue || h.Managed() == nil {
+			return nil
+		}
+	}
+	return errors.Errorf(errFmtNotStable, maxReconciles)
+}
+
+func (h *Harness) get(_ context.Context, key client.ObjectKey, obj client.Object) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.gone || key != h.name {
+		return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+	}
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(h.managed.DeepCopyObject()).Elem())
+	return nil
+}
+
+func (h *Harness) update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	if err := h.store(obj); err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.forgetIfFinalized()
+	return nil
+}
+
+func (h *Harness) store(obj client.Object) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.gone {
+		return kerrors.NewNotFound(schema.GroupResource{}, obj.GetName())
+	}
+	mg, ok := obj.DeepCopyObject().(resource.Managed)
+	if !ok {
+		return errors.Errorf(errFmtNotManaged, obj)
+	}
+	h.managed = mg
+	return nil
+}
+
+// forgetIfFinalized must be called with h.mu held.
+func (h *Harness) forgetIfFinalized() {
+	if h.managed.GetDeletionTimestamp() != nil && len(h.managed.GetFinalizers()) == 0 {
+		h.gone = true
+	}
+}
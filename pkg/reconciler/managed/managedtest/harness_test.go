@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestHarness(t *testing.T) {
+	desired := func(mg resource.Managed) any { return mg.GetLabels() }
+	maxReconciles := 5
+
+	type args struct {
+		// setup runs before the harness's calls are reset.
+		setup func(ctx context.Context, h *Harness) error
+		// steps run after the harness's calls are reset.
+		steps func(ctx context.Context, h *Harness) error
+	}
+	type want struct {
+		err      error
+		calls    []Operation
+		external any
+		exists   bool
+		deleted  bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Create": {
+			reason: "A new managed resource should cause its external resource to be created, then observed as up to date.",
+			args: args{
+				steps: func(ctx context.Context, h *Harness) error {
+					return h.ReconcileUntilStable(ctx, maxReconciles)
+				},
+			},
+			want: want{
+				calls:    []Operation{OperationObserve, OperationCreate, OperationObserve},
+				external: map[string]string{"size": "small"},
+				exists:   true,
+			},
+		},
+		"NoOp": {
+			reason: "An up to date external resource should only be observed.",
+			args: args{
+				setup: func(ctx context.Context, h *Harness) error {
+					return h.ReconcileUntilStable(ctx, maxReconciles)
+				},
+				steps: func(ctx context.Context, h *Harness) error {
+					return h.ReconcileUntilStable(ctx, maxReconciles)
+				},
+			},
+			want: want{
+				calls:    []Operation{OperationObserve},
+				external: map[string]string{"size": "small"},
+				exists:   true,
+			},
+		},
+		"Update": {
+			reason: "Changing the desired state of a managed resource should cause its external resource to be updated.",
+			args: args{
+				setup: func(ctx context.Context, h *Harness) error {
+					return h.ReconcileUntilStable(ctx, maxReconciles)
+				},
+				steps: func(ctx context.Context, h *Harness) error {
+					h.Update(func(mg resource.Managed) { mg.SetLabels(map[string]string{"size": "large"}) })
+					if err := h.ReconcileUntilStable(ctx, maxReconciles); err != nil {
+						return err
+					}
+					// A subsequent reconcile should find the external resource
+					// is up to date.
+					return h.ReconcileUntilStable(ctx, maxReconciles)
+				},
+			},
+			want: want{
+				calls:    []Operation{OperationObserve, OperationUpdate, OperationObserve},
+				external: map[string]string{"size": "large"},
+				exists:   true,
+			},
+		},
+		"Delete": {
+			reason: "Deleting a managed resource should cause its external resource to be deleted, then the managed resource to be forgotten.",
+			args: args{
+				setup: func(ctx context.Context, h *Harness) error {
+					return h.ReconcileUntilStable(ctx, maxReconciles)
+				},
+				steps: func(ctx context.Context, h *Harness) error {
+					h.Delete()
+					return h.ReconcileUntilStable(ctx, maxReconciles)
+				},
+			},
+			want: want{
+				calls:   []Operation{OperationObserve, OperationDelete, OperationObserve},
+				deleted: true,
+			},
+		},
+		"NotStable": {
+			reason: "A managed resource that never becomes stable should return an error.",
+			args: args{
+				steps: func(ctx context.Context, h *Harness) error {
+					return h.ReconcileUntilStable(ctx, 1)
+				},
+			},
+			want: want{
+				err:      errors.Errorf(errFmtNotStable, 1),
+				calls:    []Operation{OperationObserve, OperationCreate},
+				external: map[string]string{"size": "small"},
+				exists:   true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+				Name:   "cool",
+				Labels: map[string]string{"size": "small"},
+			}}
+			h := NewHarness(mg, desired)
+
+			if tc.args.setup != nil {
+				if err := tc.args.setup(ctx, h); err != nil {
+					t.Fatalf("\n%s\nsetup(...): unexpected error: %v", tc.reason, err)
+				}
+			}
+			h.External().ResetCalls()
+
+			err := tc.args.steps(ctx, h)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nsteps(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, h.External().Calls()); diff != "" {
+				t.Errorf("\n%s\nCalls(): -want, +got:\n%s", tc.reason, diff)
+			}
+			got, exists := h.External().Get("cool")
+			if diff := cmp.Diff(tc.want.exists, exists); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want exists, +got exists:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.external, got); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, h.Managed() == nil); diff != "" {
+				t.Errorf("\n%s\nManaged(): -want deleted, +got deleted:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
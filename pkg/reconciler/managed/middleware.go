@@ -0,0 +1,100 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// An ExternalClientMiddleware wraps an ExternalClient, for example to add
+// logging, metrics, retries, or caching to each of its calls.
+type ExternalClientMiddleware func(ExternalClient) ExternalClient
+
+// WithExternalClientMiddleware configures the Reconciler to wrap each
+// ExternalClient returned by its ExternalConnecter with the supplied
+// middleware. If this option is passed multiple times all middleware is used.
+// Middleware composes in the order it was registered; the first registered
+// middleware sees each call first.
+func WithExternalClientMiddleware(mw ...ExternalClientMiddleware) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.middleware = append(r.middleware, mw...)
+	}
+}
+
+// wrapExternalClient wraps the supplied ExternalClient with the Reconciler's
+// middleware.
+func (r *Reconciler) wrapExternalClient(ec ExternalClient) ExternalClient {
+	for i := len(r.middleware) - 1; i >= 0; i-- {
+		ec = r.middleware[i](ec)
+	}
+	return ec
+}
+
+// NewLoggingExternalClientMiddleware returns an ExternalClientMiddleware that
+// logs each call to an ExternalClient, its duration, and its error, if any, at
+// debug level.
+func NewLoggingExternalClientMiddleware(log logging.Logger) ExternalClientMiddleware {
+	return func(ec ExternalClient) ExternalClient {
+		return &loggingExternalClient{ExternalClient: ec, log: log}
+	}
+}
+
+type loggingExternalClient struct {
+	ExternalClient
+
+	log logging.Logger
+}
+
+func (c *loggingExternalClient) logCall(op string, mg resource.Managed, started time.Time, err error) {
+	kv := []any{"operation", op, "name", mg.GetName(), "duration", time.Since(started)}
+	if err != nil {
+		kv = append(kv, "error", err)
+	}
+	c.log.Debug("Called external client", kv...)
+}
+
+func (c *loggingExternalClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	t := time.Now()
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	c.logCall(SpanObserve, mg, t, err)
+	return o, err
+}
+
+func (c *loggingExternalClient) Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error) {
+	t := time.Now()
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	c.logCall(SpanCreate, mg, t, err)
+	return cr, err
+}
+
+func (c *loggingExternalClient) Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error) {
+	t := time.Now()
+	u, err := c.ExternalClient.Update(ctx, mg)
+	c.logCall(SpanUpdate, mg, t, err)
+	return u, err
+}
+
+func (c *loggingExternalClient) Delete(ctx context.Context, mg resource.Managed) (ExternalDelete, error) {
+	t := time.Now()
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	c.logCall(SpanDelete, mg, t, err)
+	return d, err
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// A countingExternalClient records each call made to it.
+type countingExternalClient struct {
+	ExternalClient
+
+	name  string
+	calls *[]string
+}
+
+func (c *countingExternalClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	*c.calls = append(*c.calls, c.name+":Observe")
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *countingExternalClient) Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error) {
+	*c.calls = append(*c.calls, c.name+":Create")
+	return c.ExternalClient.Create(ctx, mg)
+}
+
+func (c *countingExternalClient) Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error) {
+	*c.calls = append(*c.calls, c.name+":Update")
+	return c.ExternalClient.Update(ctx, mg)
+}
+
+func (c *countingExternalClient) Delete(ctx context.Context, mg resource.Managed) (ExternalDelete, error) {
+	*c.calls = append(*c.calls, c.name+":Delete")
+	return c.ExternalClient.Delete(ctx, mg)
+}
+
+func countingMiddleware(name string, calls *[]string) ExternalClientMiddleware {
+	return func(ec ExternalClient) ExternalClient {
+		return &countingExternalClient{ExternalClient: ec, name: name, calls: calls}
+	}
+}
+
+func TestReconcilerExternalClientMiddleware(t *testing.T) {
+	now := metav1.Now()
+
+	type args struct {
+		mg  *fake.Managed
+		obs ExternalObservation
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []string
+	}{
+		"UpToDate": {
+			reason: "Middleware should see the Observe call.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: []string{"a:Observe", "b:Observe"},
+		},
+		"Create": {
+			reason: "Middleware should see the Observe and Create calls, in registration order.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: false},
+			},
+			want: []string{"a:Observe", "b:Observe", "a:Create", "b:Create"},
+		},
+		"Update": {
+			reason: "Middleware should see the Observe and Update calls, in registration order.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+			want: []string{"a:Observe", "b:Observe", "a:Update", "b:Update"},
+		},
+		"Delete": {
+			reason: "Middleware should see the Observe and Delete calls, in registration order.",
+			args: args{
+				mg:  &fake.Managed{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}},
+				obs: ExternalObservation{ResourceExists: true},
+			},
+			want: []string{"a:Observe", "b:Observe", "a:Delete", "b:Delete"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := []string{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *tc.args.mg
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithExternalClientMiddleware(countingMiddleware("a", &calls)),
+				WithExternalClientMiddleware(countingMiddleware("b", &calls)),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.args.obs, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{}, nil
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							return ExternalDelete{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, calls); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A debugRecorder is a logging.Logger that records debug messages.
+type debugRecorder struct {
+	logging.Logger
+
+	kvs *[][]any
+}
+
+func (l debugRecorder) Debug(_ string, kv ...any) { *l.kvs = append(*l.kvs, kv) }
+
+func TestLoggingExternalClientMiddleware(t *testing.T) {
+	errBoom := errors.New("boom")
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+
+	kvs := [][]any{}
+	ec := NewLoggingExternalClientMiddleware(debugRecorder{Logger: logging.NewNopLogger(), kvs: &kvs})(&ExternalClientFns{
+		ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+			return ExternalObservation{}, nil
+		},
+		CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) { return ExternalCreation{}, nil },
+		UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) { return ExternalUpdate{}, nil },
+		DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) { return ExternalDelete{}, errBoom },
+	})
+
+	ctx := context.Background()
+	_, _ = ec.Observe(ctx, mg)
+	_, _ = ec.Create(ctx, mg)
+	_, _ = ec.Update(ctx, mg)
+	if _, err := ec.Delete(ctx, mg); !errors.Is(err, errBoom) {
+		t.Errorf("ec.Delete(...): want error %v, got %v", errBoom, err)
+	}
+
+	want := [][]any{
+		{"operation", SpanObserve, "name", "cool", "duration", time.Duration(0)},
+		{"operation", SpanCreate, "name", "cool", "duration", time.Duration(0)},
+		{"operation", SpanUpdate, "name", "cool", "duration", time.Duration(0)},
+		{"operation", SpanDelete, "name", "cool", "duration", time.Duration(0), "error", errBoom},
+	}
+	// Durations vary, so we don't compare them.
+	ignoreDurations := cmp.Comparer(func(_, _ time.Duration) bool { return true })
+	if diff := cmp.Diff(want, kvs, ignoreDurations, test.EquateErrors()); diff != "" {
+		t.Errorf("Debug(...): -want, +got:\n%s", diff)
+	}
+}
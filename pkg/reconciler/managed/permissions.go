@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const errInsufficientPermissions = "insufficient permissions"
+
+// A PermissionErrorPolicy determines how the Reconciler handles errors that
+// indicate the provider's credentials lack permission to create or update an
+// external resource.
+type PermissionErrorPolicy string
+
+// Permission error policies.
+const (
+	// RequeueWithBackoff requeues with backoff when the provider's
+	// credentials lack permission to create or update an external resource,
+	// like it does for any other error.
+	RequeueWithBackoff PermissionErrorPolicy = "RequeueWithBackoff"
+
+	// StopRequeue stops requeueing when the provider's credentials lack
+	// permission to create or update an external resource.
+	StopRequeue PermissionErrorPolicy = "StopRequeue"
+)
+
+// WithPermissionErrorPolicy configures how the Reconciler handles errors
+// returned by Create or Update that indicate the provider's credentials lack
+// permission, per errors.IsForbidden. Retrying such errors is usually
+// pointless until the managed resource's spec or the provider's credentials
+// change. When the policy is StopRequeue the Reconciler sets a Synced
+// condition explaining that the provider has insufficient permissions and
+// doesn't requeue. The managed resource is reconciled again when it next
+// changes, or when the controller otherwise resyncs it. The Reconciler uses
+// RequeueWithBackoff by default.
+func WithPermissionErrorPolicy(p PermissionErrorPolicy) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.permissionErrorPolicy = p
+	}
+}
+
+// stopOnPermissionError returns true if the supplied error indicates that the
+// provider's credentials lack permission, and the Reconciler should stop
+// requeueing because of it.
+func (r *Reconciler) stopOnPermissionError(err error) bool {
+	return r.permissionErrorPolicy == StopRequeue && errors.IsForbidden(err)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestReconcilerPermissionErrorPolicy(t *testing.T) {
+	errForbidden := kerrors.NewForbidden(schema.GroupResource{Group: "example.org", Resource: "databases"}, "cool", errors.New("denied"))
+	errBoom := errors.New("boom")
+
+	type args struct {
+		o   []ReconcilerOption
+		obs ExternalObservation
+		err error
+	}
+
+	type want struct {
+		result reconcile.Result
+		cond   xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"CreateForbiddenByDefault": {
+			reason: "By default we should requeue when Create returns a forbidden error.",
+			args: args{
+				obs: ExternalObservation{ResourceExists: false},
+				err: errForbidden,
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				cond:   xpv1.ReconcileError(errors.Wrap(errForbidden, errReconcileCreate)),
+			},
+		},
+		"CreateForbiddenStopRequeue": {
+			reason: "We should explain that the provider has insufficient permissions and stop requeueing when Create returns a forbidden error.",
+			args: args{
+				o:   []ReconcilerOption{WithPermissionErrorPolicy(StopRequeue)},
+				obs: ExternalObservation{ResourceExists: false},
+				err: errForbidden,
+			},
+			want: want{
+				result: reconcile.Result{Requeue: false},
+				cond:   xpv1.ReconcileError(errors.Wrap(errors.Wrap(errForbidden, errReconcileCreate), errInsufficientPermissions)),
+			},
+		},
+		"CreateErrorStopRequeue": {
+			reason: "We should requeue when Create returns an error that isn't forbidden, even if we stop requeueing on permission errors.",
+			args: args{
+				o:   []ReconcilerOption{WithPermissionErrorPolicy(StopRequeue)},
+				obs: ExternalObservation{ResourceExists: false},
+				err: errBoom,
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				cond:   xpv1.ReconcileError(errors.Wrap(errBoom, errReconcileCreate)),
+			},
+		},
+		"UpdateForbiddenStopRequeue": {
+			reason: "We should explain that the provider has insufficient permissions and stop requeueing when Update returns a forbidden error.",
+			args: args{
+				o:   []ReconcilerOption{WithPermissionErrorPolicy(StopRequeue)},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				err: errForbidden,
+			},
+			want: want{
+				result: reconcile.Result{Requeue: false},
+				cond:   xpv1.ReconcileError(errors.Wrap(errors.Wrap(errForbidden, errReconcileUpdate), errInsufficientPermissions)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got.cond = obj.(*fake.Managed).GetCondition(xpv1.TypeSynced)
+					return nil
+				}),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.args.obs, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{}, tc.args.err
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{}, tc.args.err
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.result = result
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package managed
 
 import (
+	"context"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -213,3 +214,23 @@ func (m *ManagementPoliciesResolver) ShouldDelete() bool {
 	// Action (obeys non-default configuration)
 	return false
 }
+
+type allowedActionsKey struct{}
+
+func withAllowedActions(ctx context.Context, p ManagementPoliciesChecker) context.Context {
+	return context.WithValue(ctx, allowedActionsKey{}, p)
+}
+
+// AllowedActionsFromContext returns the management policies resolved for the
+// managed resource being reconciled. The managed reconciler makes them
+// available to the context passed to an ExternalConnecter and the
+// ExternalClient it returns, so that an ExternalClient can skip work for
+// actions that aren't allowed, for example expensive diffing when Update isn't
+// allowed. If the context has no resolved management policies all actions are
+// allowed.
+func AllowedActionsFromContext(ctx context.Context) ManagementPoliciesChecker {
+	if p, ok := ctx.Value(allowedActionsKey{}).(ManagementPoliciesChecker); ok {
+		return p
+	}
+	return NewManagementPoliciesResolver(false, nil, xpv1.DeletionDelete)
+}
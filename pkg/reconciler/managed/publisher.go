@@ -18,41 +18,51 @@ package managed
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"text/template"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
-const errSecretStoreDisabled = "cannot publish to secret store, feature is not enabled"
+const (
+	errSecretStoreDisabled = "cannot publish to secret store, feature is not enabled"
+	errParseKeyTemplate    = "cannot parse connection detail key template"
+	errExecuteKeyTemplate  = "cannot execute connection detail key template"
+	errRemappedKeyEmpty    = "connection detail key template produced an empty key"
+
+	errFmtKeyCollision = "connection detail keys %q and %q both map to %q"
+)
 
 // A PublisherChain chains multiple ManagedPublishers.
 type PublisherChain []ConnectionPublisher
 
-// PublishConnection calls each ConnectionPublisher.PublishConnection serially. It returns the first error it
-// encounters, if any.
+// PublishConnection calls each ConnectionPublisher.PublishConnection serially.
+// It calls every publisher even if some fail, and returns all errors it
+// encounters, joined.
 func (pc PublisherChain) PublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c ConnectionDetails) (bool, error) {
 	published := false
+	errs := make([]error, 0, len(pc))
 	for _, p := range pc {
 		pb, err := p.PublishConnection(ctx, o, c)
-		if err != nil {
-			return published, err
-		}
+		errs = append(errs, err)
 		if pb {
 			published = true
 		}
 	}
-	return published, nil
+	return published, errors.Join(errs...)
 }
 
-// UnpublishConnection calls each ConnectionPublisher.UnpublishConnection serially. It returns the first error it
-// encounters, if any.
+// UnpublishConnection calls each ConnectionPublisher.UnpublishConnection
+// serially. It calls every publisher even if some fail, and returns all errors
+// it encounters, joined.
 func (pc PublisherChain) UnpublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c ConnectionDetails) error {
+	errs := make([]error, 0, len(pc))
 	for _, p := range pc {
-		if err := p.UnpublishConnection(ctx, o, c); err != nil {
-			return err
-		}
+		errs = append(errs, p.UnpublishConnection(ctx, o, c))
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 // DisabledSecretStoreManager is a connection details manager that returns a proper
@@ -76,3 +86,80 @@ func (m *DisabledSecretStoreManager) UnpublishConnection(_ context.Context, so r
 	}
 	return nil
 }
+
+// A RemappingPublisher remaps the keys of connection details before passing
+// them to another ConnectionPublisher.
+type RemappingPublisher struct {
+	publisher ConnectionPublisher
+	mapping   map[string]*template.Template
+}
+
+// NewRemappingPublisher returns a ConnectionPublisher that remaps connection
+// detail keys according to the supplied mapping before passing them to the
+// supplied ConnectionPublisher. The mapping is keyed by the original
+// connection detail key. Each value is a Go template that is executed with
+// the connection secret owner (i.e. the managed resource) as its data, for
+// example {{ .GetName }}-endpoint. Keys that don't appear in the mapping are
+// passed through unchanged. It returns an error if any template can't be
+// parsed.
+func NewRemappingPublisher(p ConnectionPublisher, mapping map[string]string) (*RemappingPublisher, error) {
+	m := make(map[string]*template.Template, len(mapping))
+	for k, tmpl := range mapping {
+		t, err := template.New(k).Option("missingkey=error").Parse(tmpl)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseKeyTemplate)
+		}
+		m[k] = t
+	}
+	return &RemappingPublisher{publisher: p, mapping: m}, nil
+}
+
+// PublishConnection remaps the supplied connection details, then publishes
+// them.
+func (p *RemappingPublisher) PublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c ConnectionDetails) (bool, error) {
+	rc, err := p.remap(o, c)
+	if err != nil {
+		return false, err
+	}
+	return p.publisher.PublishConnection(ctx, o, rc)
+}
+
+// UnpublishConnection remaps the supplied connection details, then
+// unpublishes them.
+func (p *RemappingPublisher) UnpublishConnection(ctx context.Context, o resource.ConnectionSecretOwner, c ConnectionDetails) error {
+	rc, err := p.remap(o, c)
+	if err != nil {
+		return err
+	}
+	return p.publisher.UnpublishConnection(ctx, o, rc)
+}
+
+func (p *RemappingPublisher) remap(o resource.ConnectionSecretOwner, c ConnectionDetails) (ConnectionDetails, error) {
+	// Iterate in a stable order so that collision errors are deterministic.
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make(ConnectionDetails, len(c))
+	from := make(map[string]string, len(c))
+	for _, k := range keys {
+		to := k
+		if t, ok := p.mapping[k]; ok {
+			b := &strings.Builder{}
+			if err := t.Execute(b, o); err != nil {
+				return nil, errors.Wrap(err, errExecuteKeyTemplate)
+			}
+			if to = b.String(); to == "" {
+				return nil, errors.New(errRemappedKeyEmpty)
+			}
+		}
+		if prev, ok := from[to]; ok {
+			return nil, errors.Errorf(errFmtKeyCollision, prev, k, to)
+		}
+		from[to] = k
+		out[to] = c[k]
+	}
+	return out, nil
+}
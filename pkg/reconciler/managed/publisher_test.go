@@ -18,9 +18,11 @@ package managed
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -32,6 +34,7 @@ import (
 var (
 	_ ConnectionPublisher = &APISecretPublisher{}
 	_ ConnectionPublisher = PublisherChain{}
+	_ ConnectionPublisher = &RemappingPublisher{}
 )
 
 func TestPublisherChain(t *testing.T) {
@@ -47,6 +50,7 @@ func TestPublisherChain(t *testing.T) {
 	}
 
 	errBoom := errors.New("boom")
+	errBang := errors.New("bang")
 
 	cases := map[string]struct {
 		p    ConnectionPublisher
@@ -98,7 +102,35 @@ func TestPublisherChain(t *testing.T) {
 				c:   ConnectionDetails{},
 			},
 			want: want{
-				err: errBoom,
+				err: errors.Join(errBoom),
+			},
+		},
+		"SomePublishersReturnError": {
+			p: PublisherChain{
+				ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ ConnectionDetails) (bool, error) {
+						return false, errBoom
+					},
+				},
+				ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ ConnectionDetails) (bool, error) {
+						return true, nil
+					},
+				},
+				ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ ConnectionDetails) (bool, error) {
+						return false, errBang
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{},
+				c:   ConnectionDetails{},
+			},
+			want: want{
+				err:       errors.Join(errBoom, errBang),
+				published: true,
 			},
 		},
 	}
@@ -203,3 +235,115 @@ func TestDisabledSecretStoreUnpublish(t *testing.T) {
 		})
 	}
 }
+
+func TestRemappingPublisher(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+		c   ConnectionDetails
+
+		// changed is whether the wrapped publisher reports that it changed
+		// the published details.
+		changed bool
+	}
+
+	type want struct {
+		err       error
+		published ConnectionDetails
+		changed   bool
+	}
+
+	cases := map[string]struct {
+		reason  string
+		mapping map[string]string
+		args    args
+		want    want
+	}{
+		"Rename": {
+			reason:  "Mapped keys should be renamed, and unmapped keys passed through unchanged.",
+			mapping: map[string]string{"endpoint": "DB_HOST"},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{},
+				c:   ConnectionDetails{"endpoint": []byte("example.org"), "port": []byte("5432")},
+
+				changed: true,
+			},
+			want: want{
+				published: ConnectionDetails{"DB_HOST": []byte("example.org"), "port": []byte("5432")},
+				changed:   true,
+			},
+		},
+		"TemplateRename": {
+			reason:  "Mapped keys may be templated using the managed resource.",
+			mapping: map[string]string{"endpoint": "{{ .GetName }}_HOST"},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool-db"}},
+				c:   ConnectionDetails{"endpoint": []byte("example.org")},
+
+				changed: true,
+			},
+			want: want{
+				published: ConnectionDetails{"cool-db_HOST": []byte("example.org")},
+				changed:   true,
+			},
+		},
+		"Unchanged": {
+			reason:  "Whether the wrapped publisher changed the published details should be passed through.",
+			mapping: map[string]string{"endpoint": "DB_HOST"},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{},
+				c:   ConnectionDetails{"endpoint": []byte("example.org")},
+			},
+			want: want{
+				published: ConnectionDetails{"DB_HOST": []byte("example.org")},
+			},
+		},
+		"Collision": {
+			reason:  "Two keys that map to the same key should return an error.",
+			mapping: map[string]string{"endpoint": "port"},
+			args: args{
+				ctx: context.Background(),
+				mg:  &fake.Managed{},
+				c:   ConnectionDetails{"endpoint": []byte("example.org"), "port": []byte("5432")},
+			},
+			want: want{
+				err: errors.Errorf(errFmtKeyCollision, "endpoint", "port", "port"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var published ConnectionDetails
+			p, err := NewRemappingPublisher(ConnectionPublisherFns{
+				PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, c ConnectionDetails) (bool, error) {
+					published = c
+					return tc.args.changed, nil
+				},
+			}, tc.mapping)
+			if err != nil {
+				t.Fatalf("\n%s\nNewRemappingPublisher(...): unexpected error: %v", tc.reason, err)
+			}
+			changed, err := p.PublishConnection(tc.args.ctx, tc.args.mg, tc.args.c)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPublish(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.published, published); diff != "" {
+				t.Errorf("\n%s\nPublish(...): -want published, +got published:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("\n%s\nPublish(...): -want changed, +got changed:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewRemappingPublisherParseError(t *testing.T) {
+	_, err := NewRemappingPublisher(ConnectionPublisherFns{}, map[string]string{"endpoint": "{{ .GetName "})
+	if err == nil || !strings.HasPrefix(err.Error(), errParseKeyTemplate) {
+		t.Errorf("NewRemappingPublisher(...): want error %q, got %v", errParseKeyTemplate, err)
+	}
+}
@@ -18,13 +18,17 @@ package managed
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -65,6 +69,14 @@ const (
 	errReconcileUpdate          = "update failed"
 	errReconcileDelete          = "delete failed"
 	errRecordChangeLog          = "cannot record change log entry"
+	errReconcilePreHook         = "pre-reconcile hook failed"
+	errAcquireLease             = "cannot acquire lease on managed resource"
+	errTrackUsage               = "cannot track provider config usage"
+	errResolveConnectionDetails = "cannot resolve referenced connection details"
+	errMutateManaged            = "cannot mutate managed resource"
+	errValidateSpec             = "invalid managed resource spec"
+	errFmtResolveRefsTimeout    = "cannot resolve references within the %s reference resolution timeout"
+	errFmtOrphaned              = "deletion of external resource failed for longer than the %s deletion grace period - removing finalizer and orphaning the external resource"
 
 	errExternalResourceNotExist = "external resource does not exist"
 )
@@ -83,15 +95,41 @@ const (
 	reasonCannotUpdate            event.Reason = "CannotUpdateExternalResource"
 	reasonCannotUpdateManaged     event.Reason = "CannotUpdateManagedResource"
 	reasonManagementPolicyInvalid event.Reason = "CannotUseInvalidManagementPolicy"
+	reasonCannotPreReconcile      event.Reason = "CannotRunPreReconcileHook"
+	reasonCannotTrackUsage        event.Reason = "CannotTrackProviderConfigUsage"
+	reasonOrphaned                event.Reason = "OrphanedExternalResource"
+	reasonCannotMutate            event.Reason = "CannotMutateManagedResource"
+	reasonInvalidSpec             event.Reason = "InvalidManagedResourceSpec"
 
 	reasonDeleted event.Reason = "DeletedExternalResource"
 	reasonCreated event.Reason = "CreatedExternalResource"
 	reasonUpdated event.Reason = "UpdatedExternalResource"
 	reasonPending event.Reason = "PendingExternalResource"
 
+	reasonResolvedRefs event.Reason = "ResolvedReferences"
+
 	reasonReconciliationPaused event.Reason = "ReconciliationPaused"
 )
 
+// Messages explaining why reconciliation is paused.
+const (
+	msgPausedGlobally     = "Reconciliation is globally paused"
+	msgPausedByAnnotation = "Reconciliation is paused by the " + meta.AnnotationKeyReconciliationPaused + " annotation"
+	msgFmtPausedUntil     = "Reconciliation is paused by the " + meta.AnnotationKeyReconciliationPausedUntil + " annotation until %s"
+	msgPausedByPolicies   = "Reconciliation is paused because spec.managementPolicies is empty"
+)
+
+// pausedMessage returns a message explaining that reconciliation of the
+// supplied managed resource is paused for the supplied cause. The reason
+// recorded by the managed resource's paused reason annotation, if any, is
+// appended.
+func pausedMessage(mg resource.Managed, cause string) string {
+	if reason := meta.GetPausedReason(mg); reason != "" {
+		return cause + ": " + reason
+	}
+	return cause
+}
+
 // ControllerName returns the recommended name for controllers that use this
 // package to reconcile a particular kind of managed resource.
 func ControllerName(kind string) string {
@@ -210,6 +248,16 @@ func (m InitializerFn) Initialize(ctx context.Context, mg resource.Managed) erro
 	return m(ctx, mg)
 }
 
+// An InitializerErrorHandler determines whether the Reconciler should requeue
+// after an Initializer returned the supplied error.
+type InitializerErrorHandler func(err error) (requeue bool)
+
+// RequeueUnlessTerminal is the default InitializerErrorHandler. It requeues
+// unless the supplied error is terminal per resource.IsTerminal.
+func RequeueUnlessTerminal(err error) bool {
+	return !resource.IsTerminal(err)
+}
+
 // A ReferenceResolver resolves references to other managed resources.
 type ReferenceResolver interface {
 	// ResolveReferences resolves all fields in the supplied managed resource
@@ -349,6 +397,11 @@ type ExternalClient = TypedExternalClient[resource.Managed]
 // idempotent. For example, Create call should not return AlreadyExists error
 // if it's called again with the same parameters or Delete call should not
 // return error if there is an ongoing deletion or resource does not exist.
+//
+// Errors that will not be fixed by retrying, for example because the external
+// API rejected an invalid parameter, may be marked using resource.Terminal.
+// The Reconciler does not retry after a terminal error until the managed
+// resource's spec, and thus its generation, changes.
 type TypedExternalClient[managedType resource.Managed] interface {
 	// Observe the external resource the supplied Managed resource
 	// represents, if any. Observe implementations must not modify the
@@ -490,6 +543,13 @@ type ExternalObservation struct {
 	// resource every time they are called.
 	ResourceLateInitialized bool
 
+	// ResourceDeleting should be true if the corresponding external resource
+	// exists, but is in the process of being deleted. Crossplane uses this
+	// information to avoid requesting deletion of an external resource that
+	// is already being deleted. Instead it waits for the external resource to
+	// cease to exist.
+	ResourceDeleting bool
+
 	// ConnectionDetails required to connect to this resource. These details
 	// are a set that is collated throughout the managed resource's lifecycle -
 	// i.e. returning new connection details will have no affect on old details
@@ -502,6 +562,12 @@ type ExternalObservation struct {
 	// finding where the observed diverges from the desired state.
 	// The string should be a cmp.Diff that details the difference.
 	Diff string
+
+	// RequeueAfter, if positive, overrides the poll interval after which the
+	// managed resource is next reconciled. It's useful when the external
+	// client knows when the external resource is likely to change, for
+	// example when an asynchronous operation is expected to complete.
+	RequeueAfter time.Duration
 }
 
 // An ExternalCreation is the result of the creation of an external resource.
@@ -516,6 +582,11 @@ type ExternalCreation struct {
 	// AdditionalDetails represent any additional details the external client
 	// wants to return about the creation operation that was performed.
 	AdditionalDetails AdditionalDetails
+
+	// RequeueAfter, if positive, overrides the poll interval after which the
+	// managed resource is next reconciled, for example to observe it when
+	// its creation is expected to complete.
+	RequeueAfter time.Duration
 }
 
 // An ExternalUpdate is the result of an update to an external resource.
@@ -534,6 +605,12 @@ type ExternalUpdate struct {
 
 // An ExternalDelete is the result of a deletion of an external resource.
 type ExternalDelete struct {
+	// ConnectionDetails of the deleted resource, for example its last known
+	// endpoint. These details are published before the managed resource's
+	// connection details are unpublished, but only if the Reconciler was
+	// configured to publish deletion details.
+	ConnectionDetails ConnectionDetails
+
 	// AdditionalDetails represent any additional details the external client
 	// wants to return about the delete operation that was performed.
 	AdditionalDetails AdditionalDetails
@@ -550,9 +627,74 @@ type Reconciler struct {
 	pollInterval     time.Duration
 	pollIntervalHook PollIntervalHook
 
+	deletionPolicyHook DeletionPolicyHook
+
+	preReconcileHook  PreReconcileHook
+	postReconcileHook PostReconcileHook
+	resultHook        ResultHook
+
+	lease LeaseManager
+
+	statusUpdateStrategy StatusUpdateStrategy
+	conditionTransformer ConditionTransformer
+
+	contextDecorator ContextDecorator
+
+	gvk    schema.GroupVersionKind
+	tracer trace.Tracer
+
+	usage resource.Tracker
+
+	connectionDetails ConnectionDetailsResolver
+
+	staleConditionsHook StaleConditionsHook
+
+	mutator ManagedMutator
+
+	specValidator SpecValidator
+
+	concurrency ConcurrencyController
+
+	middleware []ExternalClientMiddleware
+
+	deletionGracePeriod time.Duration
+
+	observeOnOrphanDelete bool
+
+	immediateCreateRequeue bool
+
+	publishDeletionDetails bool
+
+	resolvedRefsEvents bool
+
+	requeueOnConnectionDetailsChange bool
+
+	observeRetry *wait.Backoff
+
+	globalPause func() bool
+
+	externalNameRules *meta.ExternalNameRules
+
+	connectionDetailsMerge           ConnectionDetailsMergePolicy
+	observationConnectionDetailsOnly bool
+
+	permissionErrorPolicy PermissionErrorPolicy
+
+	syncEvents *syncEventTracker
+
 	timeout             time.Duration
+	resolveTimeout      time.Duration
 	creationGracePeriod time.Duration
 
+	observeTimeout time.Duration
+	createTimeout  time.Duration
+	updateTimeout  time.Duration
+	deleteTimeout  time.Duration
+
+	clock clock.Clock
+
+	initializerErrorHandler InitializerErrorHandler
+
 	features feature.Flags
 
 	// The below structs embed the set of interfaces used to implement the
@@ -563,6 +705,9 @@ type Reconciler struct {
 	managed  mrManaged
 
 	supportedManagementPolicies []sets.Set[xpv1.ManagementAction]
+	defaultManagementPolicies   xpv1.ManagementPolicies
+
+	id string
 
 	log            logging.Logger
 	record         event.Recorder
@@ -572,6 +717,7 @@ type Reconciler struct {
 
 type mrManaged struct {
 	CriticalAnnotationUpdater
+	ManagedUpdater
 	ConnectionPublisher
 	resource.Finalizer
 	Initializer
@@ -581,6 +727,7 @@ type mrManaged struct {
 func defaultMRManaged(m manager.Manager) mrManaged {
 	return mrManaged{
 		CriticalAnnotationUpdater: NewRetryingCriticalAnnotationUpdater(m.GetClient()),
+		ManagedUpdater:            NewAPIUpdatingManagedUpdater(m.GetClient()),
 		Finalizer:                 resource.NewAPIFinalizer(m.GetClient(), FinalizerName),
 		Initializer:               NewNameAsExternalName(m.GetClient()),
 		ReferenceResolver:         NewAPISimpleReferenceResolver(m.GetClient()),
@@ -614,6 +761,186 @@ func WithTimeout(duration time.Duration) ReconcilerOption {
 	}
 }
 
+// WithReferenceResolutionTimeout specifies a timeout for resolving the
+// references of a managed resource, separate from the timeout for the entire
+// reconcile. This prevents slow reference resolution from consuming the time
+// available to call the external system. By default reference resolution is
+// bounded only by the reconcile timeout.
+func WithReferenceResolutionTimeout(duration time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.resolveTimeout = duration
+	}
+}
+
+// WithObserveTimeout specifies a timeout for observing the external resource,
+// separate from the timeout for the entire reconcile. The timeout includes any
+// retries configured using WithObserveRetry. By default observing is bounded
+// only by the reconcile timeout.
+func WithObserveTimeout(duration time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.observeTimeout = duration
+	}
+}
+
+// WithCreateTimeout specifies a timeout for each call to the external client's
+// Create method, separate from the timeout for the entire reconcile. By
+// default Create is bounded only by the reconcile timeout.
+func WithCreateTimeout(duration time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.createTimeout = duration
+	}
+}
+
+// WithUpdateTimeout specifies a timeout for each call to the external client's
+// Update method, separate from the timeout for the entire reconcile. By
+// default Update is bounded only by the reconcile timeout.
+func WithUpdateTimeout(duration time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.updateTimeout = duration
+	}
+}
+
+// WithDeleteTimeout specifies a timeout for each call to the external client's
+// Delete method, separate from the timeout for the entire reconcile. By
+// default Delete is bounded only by the reconcile timeout.
+func WithDeleteTimeout(duration time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.deleteTimeout = duration
+	}
+}
+
+// requeueAfter returns the supplied requeue hint returned by the external
+// client if it's positive. Otherwise it returns the poll interval of the
+// supplied managed resource.
+func (r *Reconciler) requeueAfter(mg resource.Managed, hint time.Duration) time.Duration {
+	if hint > 0 {
+		return hint
+	}
+	return r.pollIntervalHook(mg, r.pollInterval)
+}
+
+// phaseContext derives a context for a single external client call from the
+// supplied context, bounded by the supplied timeout if it is positive. The
+// derived context is always subject to the supplied context's deadline.
+func phaseContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// resolveReferences resolves the references of the supplied managed resource,
+// bounded by the reference resolution timeout if one is configured.
+func (r *Reconciler) resolveReferences(ctx context.Context, mg resource.Managed) error {
+	if r.resolveTimeout <= 0 {
+		return r.managed.ResolveReferences(ctx, mg)
+	}
+	rctx, cancel := context.WithTimeout(ctx, r.resolveTimeout)
+	defer cancel()
+	err := r.managed.ResolveReferences(rctx, mg)
+	if err != nil && errors.Is(rctx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return errors.Wrapf(err, errFmtResolveRefsTimeout, r.resolveTimeout)
+	}
+	return err
+}
+
+// WithGlobalPause configures the Reconciler to pause reconciliation of all
+// managed resources while the supplied function returns true, for example
+// during maintenance. The function is called at the start of each reconcile,
+// so it should be cheap; e.g. it might read an atomic bool that's toggled by an
+// admin endpoint. Managed resources are polled while reconciliation is paused,
+// so that they resume once it's no longer paused.
+func WithGlobalPause(paused func() bool) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.globalPause = paused
+	}
+}
+
+// WithExternalNameValidation configures the Reconciler to validate the
+// external name of a managed resource against the supplied rules before it
+// creates the external resource. A managed resource whose external name
+// violates the rules fails terminally, and won't be reconciled again until its
+// spec changes. Managed resources without an external name aren't validated,
+// because their external name is expected to be set by Create.
+func WithExternalNameValidation(rules meta.ExternalNameRules) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.externalNameRules = &rules
+	}
+}
+
+// WithObserveRetry configures the Reconciler to retry an ExternalClient's
+// Observe call up to the supplied number of attempts, using the supplied
+// backoff, if it returns a transient error. Errors are considered transient if
+// they satisfy errors.IsRetryable. This is useful for external APIs that are
+// eventually consistent, for example immediately after an external resource is
+// created. Retries happen within a single reconcile; if every attempt fails the
+// managed resource is requeued as usual.
+func WithObserveRetry(attempts int, backoff wait.Backoff) ReconcilerOption {
+	return func(r *Reconciler) {
+		backoff.Steps = max(attempts, 1)
+		r.observeRetry = &backoff
+	}
+}
+
+// observe the supplied managed resource's external resource, retrying if
+// configured to. We stop retrying once the supplied context is done, so that
+// retries are bounded by the observe timeout.
+func (r *Reconciler) observe(ctx context.Context, ec ExternalClient, mg resource.Managed) (ExternalObservation, error) {
+	if r.observeRetry == nil {
+		return ec.Observe(ctx, mg)
+	}
+	var o ExternalObservation
+	var oerr error
+	err := wait.ExponentialBackoffWithContext(ctx, *r.observeRetry, func(ctx context.Context) (bool, error) {
+		o, oerr = ec.Observe(ctx, mg)
+		switch {
+		case oerr == nil:
+			return true, nil
+		case ctx.Err() != nil, !errors.IsRetryable(oerr):
+			return false, oerr
+		}
+		return false, nil
+	})
+	// We return the error Observe last returned, rather than an error that
+	// indicates we ran out of attempts or time.
+	if err != nil && oerr != nil {
+		return o, oerr
+	}
+	return o, err
+}
+
+// WithRequeueOnConnectionDetailsChange configures the Reconciler to requeue a
+// managed resource immediately, rather than after the poll interval, when its
+// external resource is up to date but publishing its connection details
+// changed them, for example because a password was rotated. This lets the
+// Reconciler promptly confirm the new details are stable.
+func WithRequeueOnConnectionDetailsChange() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.requeueOnConnectionDetailsChange = true
+	}
+}
+
+// WithResolvedReferencesEvents configures the Reconciler to record a normal
+// event when a managed resource's references are resolved after previously
+// failing to resolve. The Reconciler tracks whether references were resolved
+// using the ReferencesResolved condition, so the event is only recorded when
+// resolution transitions from failing to succeeding, not on every reconcile.
+func WithResolvedReferencesEvents() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.resolvedRefsEvents = true
+	}
+}
+
+// WithManagedObjectFactory specifies how the Reconciler should construct the
+// managed resources it reconciles. By default they're constructed by looking
+// up the reconciled kind in the controller manager's scheme, which requires
+// the kind to be registered when the Reconciler is created.
+func WithManagedObjectFactory(fn func() resource.Managed) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.newManaged = fn
+	}
+}
+
 // WithPollInterval specifies how long the Reconciler should wait before queueing
 // a new reconciliation after a successful reconcile. The Reconciler requeues
 // after a specified duration when it is not actively waiting for an external
@@ -662,6 +989,321 @@ func WithPollJitterHook(jitter time.Duration) ReconcilerOption {
 	})
 }
 
+// A DeletionPolicyHook determines whether the Reconciler should wait for the
+// external resource of a managed resource that is being deleted to no longer
+// exist before it removes the managed resource's finalizer.
+type DeletionPolicyHook func(managed resource.Managed) (waitForDeletion bool)
+
+// WaitForExternalDeletion is a DeletionPolicyHook that waits for the external
+// resource to no longer exist before removing the managed resource's
+// finalizer. This is the default.
+func WaitForExternalDeletion(_ resource.Managed) bool {
+	return true
+}
+
+// RemoveFinalizerAfterDeleteRequest is a DeletionPolicyHook that removes the
+// managed resource's finalizer as soon as deletion of its external resource
+// has been successfully requested. It's intended for fire-and-forget APIs
+// that may never report that a deleted external resource no longer exists.
+func RemoveFinalizerAfterDeleteRequest(_ resource.Managed) bool {
+	return false
+}
+
+// WithDeletionPolicyHook adds a hook that determines whether the Reconciler
+// waits for an external resource to no longer exist before removing the
+// finalizer of a managed resource that is being deleted. If this option is
+// passed multiple times, only the latest hook will be used.
+func WithDeletionPolicyHook(hook DeletionPolicyHook) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.deletionPolicyHook = hook
+	}
+}
+
+// A PreReconcileHook is called before a managed resource is reconciled. It may
+// be used to run custom logic, such as refreshing a cached token or acquiring
+// a lock, before each reconcile. If it returns an error the managed resource
+// is not reconciled, and is requeued.
+type PreReconcileHook func(ctx context.Context, managed resource.Managed) error
+
+func defaultPreReconcileHook(_ context.Context, _ resource.Managed) error {
+	return nil
+}
+
+// A PostReconcileHook is called after a managed resource is reconciled with
+// the final result and error of the reconcile. It's called for every reconcile
+// of a managed resource that exists, including those that a PreReconcileHook
+// prevented.
+type PostReconcileHook func(ctx context.Context, managed resource.Managed, result reconcile.Result, err error)
+
+func defaultPostReconcileHook(_ context.Context, _ resource.Managed, _ reconcile.Result, _ error) {}
+
+// A ResultHook is called exactly once at the end of every reconcile with the
+// final result and error of the reconcile. Unlike a PostReconcileHook it's
+// called even if the managed resource doesn't exist, or couldn't be read, in
+// which case the supplied managed resource is nil.
+type ResultHook func(ctx context.Context, req reconcile.Request, managed resource.Managed, result reconcile.Result, err error)
+
+func defaultResultHook(_ context.Context, _ reconcile.Request, _ resource.Managed, _ reconcile.Result, _ error) {
+}
+
+// WithPreReconcileHook adds a hook that is called before each reconcile of a
+// managed resource. If this option is passed multiple times, only the latest
+// hook will be used.
+func WithPreReconcileHook(hook PreReconcileHook) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.preReconcileHook = hook
+	}
+}
+
+// WithPostReconcileHook adds a hook that is called after each reconcile of a
+// managed resource. If this option is passed multiple times, only the latest
+// hook will be used.
+func WithPostReconcileHook(hook PostReconcileHook) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.postReconcileHook = hook
+	}
+}
+
+// WithResultHook adds a hook that is called with the final result and error
+// of every reconcile, for example to log or record metrics about them. If this
+// option is passed multiple times, only the latest hook will be used.
+func WithResultHook(hook ResultHook) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.resultHook = hook
+	}
+}
+
+// WithResourceLease configures the LeaseManager used to coordinate reconciles
+// of a managed resource between several replicas of a controller. A lease on
+// the managed resource is acquired before each reconcile and released after
+// it. If the lease is held by another replica the managed resource is requeued
+// without being reconciled. Leases must outlive the reconcile timeout (see
+// WithTimeout). By default no leases are acquired.
+func WithResourceLease(lm LeaseManager) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.lease = lm
+	}
+}
+
+// WithConditionTransformer configures the Reconciler to transform the status
+// conditions of a managed resource using the supplied function each time it
+// updates its status. This allows providers to add their own conditions
+// derived from the standard Synced and Ready conditions, or to rewrite their
+// messages. If this option is passed multiple times, only the latest
+// transformer will be used.
+func WithConditionTransformer(fn ConditionTransformer) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.conditionTransformer = fn
+	}
+}
+
+// WithStatusUpdateStrategy configures when the Reconciler updates the status of
+// a managed resource. By default the status is updated at the end of every
+// reconcile. Passing StatusUpdateIfChanged skips status updates that would not
+// change the status, reducing writes to the API server. Managed resources that
+// record when they were last reconciled only do so when their status is
+// updated, so with StatusUpdateIfChanged their last reconcile time records
+// when their status last changed.
+func WithStatusUpdateStrategy(s StatusUpdateStrategy) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.statusUpdateStrategy = s
+	}
+}
+
+// A ContextDecorator decorates the context passed to an ExternalConnecter and
+// the ExternalClient it returns, for example to inject a trace span or a
+// request ID.
+type ContextDecorator func(ctx context.Context, mg resource.Managed) context.Context
+
+func defaultContextDecorator(ctx context.Context, _ resource.Managed) context.Context {
+	return ctx
+}
+
+// WithContextDecorator configures a ContextDecorator that is applied to the
+// context passed to the ExternalConnecter and the ExternalClient. The decorated
+// context is always subject to the reconcile timeout. If this option is passed
+// multiple times, only the latest decorator will be used.
+func WithContextDecorator(d ContextDecorator) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.contextDecorator = d
+	}
+}
+
+// decorateContext applies the Reconciler's ContextDecorator to the supplied
+// context. The decorated context inherits the supplied context's deadline,
+// even if the decorator doesn't derive it from the supplied context.
+func (r *Reconciler) decorateContext(ctx context.Context, mg resource.Managed) (context.Context, context.CancelFunc) {
+	dctx := r.contextDecorator(ctx, mg)
+	if d, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(dctx, d)
+	}
+	return context.WithCancel(dctx)
+}
+
+// WithProviderConfigUsageTracker configures a Tracker that is used to track
+// usage of the ProviderConfig referenced by a managed resource before the
+// Reconciler connects to the external system. This is typically a
+// resource.ProviderConfigUsageTracker, which ensures a ProviderConfigUsage
+// controlled by the managed resource exists. By default usage is not tracked.
+func WithProviderConfigUsageTracker(t resource.Tracker) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.usage = t
+	}
+}
+
+// A StaleConditionsHook is called each time a managed resource is successfully
+// reconciled, before its status is updated. Providers may use it to remove
+// conditions that no longer apply, for example by calling RemoveConditions on
+// the managed resource's ConditionedStatus.
+type StaleConditionsHook func(ctx context.Context, mg resource.Managed)
+
+func defaultStaleConditionsHook(_ context.Context, _ resource.Managed) {}
+
+// WithStaleConditionsHook adds a hook that is called each time a managed
+// resource is successfully reconciled, before its status is updated. If this
+// option is passed multiple times, only the latest hook will be used.
+func WithStaleConditionsHook(hook StaleConditionsHook) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.staleConditionsHook = hook
+	}
+}
+
+// A ManagedMutator mutates a managed resource in memory before it is
+// reconciled, for example to normalize or default its spec. Mutations are not
+// persisted, unless the managed resource is later updated for another reason
+// such as late initialization.
+type ManagedMutator func(ctx context.Context, mg resource.Managed) error
+
+func defaultManagedMutator(_ context.Context, _ resource.Managed) error { return nil }
+
+// WithManagedMutator adds a mutator that is called each time a managed
+// resource is reconciled, after it is initialized and before its references
+// are resolved. If this option is passed multiple times, only the latest
+// mutator will be used.
+func WithManagedMutator(m ManagedMutator) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.mutator = m
+	}
+}
+
+// A SpecValidator validates the spec of a managed resource before it is
+// reconciled, for example to reject a spec that the external system is known
+// to reject. It returns an error if the spec is invalid.
+type SpecValidator func(ctx context.Context, mg resource.Managed) error
+
+func defaultSpecValidator(_ context.Context, _ resource.Managed) error { return nil }
+
+// WithSpecValidator adds a validator that is called each time a managed
+// resource that hasn't been deleted is reconciled, after it is initialized and
+// mutated, and before any calls to the external system. A managed resource
+// whose spec is invalid fails terminally, and won't be reconciled again until
+// its spec changes. If this option is passed multiple times, only the latest
+// validator will be used.
+func WithSpecValidator(v SpecValidator) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.specValidator = v
+	}
+}
+
+// WithDeletionGracePeriod configures how long the Reconciler will keep trying
+// to delete an external resource after deletion first fails. Once the grace
+// period expires the Reconciler removes the managed resource's finalizer,
+// orphaning the external resource, and emits a warning event. The Reconciler
+// requeues a managed resource whose deletion fails with a terminal error no
+// later than when its grace period expires. By default the Reconciler keeps
+// trying to delete the external resource forever.
+func WithDeletionGracePeriod(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.deletionGracePeriod = d
+	}
+}
+
+// deletionGracePeriodExpired returns true if the deletion grace period is
+// enabled and deletion of the supplied managed resource's external resource
+// first failed longer than the grace period ago.
+func (r *Reconciler) deletionGracePeriodExpired(mg resource.Managed) bool {
+	if r.deletionGracePeriod <= 0 {
+		return false
+	}
+	t := meta.GetDeletionAttemptTime(mg)
+	return !t.IsZero() && r.clock.Since(t) >= r.deletionGracePeriod
+}
+
+// deletionGracePeriodRemaining returns how long remains until the deletion
+// grace period of the supplied managed resource expires. It returns zero if
+// there is no deletion grace period, or deletion hasn't yet been attempted.
+func (r *Reconciler) deletionGracePeriodRemaining(mg resource.Managed) time.Duration {
+	if r.deletionGracePeriod <= 0 {
+		return 0
+	}
+	t := meta.GetDeletionAttemptTime(mg)
+	if t.IsZero() {
+		return 0
+	}
+	return r.deletionGracePeriod - r.clock.Since(t)
+}
+
+// terminalDeletionResult returns the result with which to requeue the supplied
+// managed resource, which is being deleted, after a terminal error. We don't
+// record terminal errors for deleted resources, so nothing else will bring us
+// back to retry. We retry at our poll interval rather than backing off,
+// because retrying a terminal error sooner won't help. We retry sooner if our
+// deletion grace period expires before then, so that we orphan the external
+// resource on time.
+func (r *Reconciler) terminalDeletionResult(mg resource.Managed) reconcile.Result {
+	result := reconcile.Result{RequeueAfter: r.requeueAfter(mg, 0)}
+	if d := r.deletionGracePeriodRemaining(mg); d > 0 && d < result.RequeueAfter {
+		result.RequeueAfter = d
+	}
+	return result
+}
+
+// WithClock specifies the clock the Reconciler uses to read the current time,
+// for example when recording when the creation of an external resource is
+// pending or determining whether a grace period has expired. The Reconciler
+// uses the real clock by default.
+func WithClock(c clock.Clock) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.clock = c
+	}
+}
+
+// WithObserveOnOrphanDelete configures whether the Reconciler should connect
+// to the provider and observe the external resource when a managed resource
+// with a deletion policy of Orphan is deleted. The external resource is never
+// deleted, but the observed connection details are passed to the
+// ConnectionPublisher when unpublishing. Failing to connect or observe blocks
+// removal of the finalizer. The Reconciler doesn't observe orphaned external
+// resources by default.
+func WithObserveOnOrphanDelete(observe bool) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.observeOnOrphanDelete = observe
+	}
+}
+
+// WithImmediateCreateRequeue configures whether the Reconciler should requeue
+// immediately after it successfully creates an external resource, in order to
+// observe whether it's ready for use. When disabled the Reconciler instead
+// requeues after its poll interval. This may be useful for external systems
+// whose create operation returns a fully ready resource. The Reconciler
+// requeues immediately by default.
+func WithImmediateCreateRequeue(requeue bool) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.immediateCreateRequeue = requeue
+	}
+}
+
+// WithPublishDeletionDetails configures whether the Reconciler should publish
+// the ConnectionDetails returned by a successful ExternalClient Delete call,
+// for example to record the final details of the external resource. They are
+// published before the managed resource's connection details are unpublished.
+// Deletion details aren't published by default.
+func WithPublishDeletionDetails(publish bool) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.publishDeletionDetails = publish
+	}
+}
+
 // WithCreationGracePeriod configures an optional period during which we will
 // wait for the external API to report that a newly created external resource
 // exists. This allows us to tolerate eventually consistent APIs that do not
@@ -721,6 +1363,21 @@ func WithCriticalAnnotationUpdater(u CriticalAnnotationUpdater) ReconcilerOption
 	}
 }
 
+// WithManagedUpdater specifies how the Reconciler should persist changes it
+// makes to the metadata and spec of a managed resource, for example after it
+// is late initialized. By default the entire managed resource is updated.
+// Pass an APIPatchingManagedUpdater to send only the fields that changed,
+// avoiding conflicts with concurrent changes to other fields. Critical
+// annotations such as the external name are persisted separately; see
+// WithCriticalAnnotationUpdater and NewPatchingCriticalAnnotationUpdater.
+// Wrap the ManagedUpdater with NewConditionPreservingManagedUpdater to keep
+// the status conditions set before the update.
+func WithManagedUpdater(u ManagedUpdater) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.managed.ManagedUpdater = u
+	}
+}
+
 // WithConnectionPublishers specifies how the Reconciler should publish
 // its connection details such as credentials and endpoints.
 func WithConnectionPublishers(p ...ConnectionPublisher) ReconcilerOption {
@@ -737,6 +1394,17 @@ func WithInitializers(i ...Initializer) ReconcilerOption {
 	}
 }
 
+// WithInitializerErrorHandler specifies how the Reconciler should decide
+// whether to requeue when initializing a managed resource fails. By default
+// the Reconciler requeues unless the error is terminal per
+// resource.IsTerminal. When the handler returns false the Reconciler won't
+// retry until the managed resource's generation changes.
+func WithInitializerErrorHandler(h InitializerErrorHandler) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.initializerErrorHandler = h
+	}
+}
+
 // WithFinalizer specifies how the Reconciler should add and remove
 // finalizers to and from the managed resource.
 func WithFinalizer(f resource.Finalizer) ReconcilerOption {
@@ -745,6 +1413,16 @@ func WithFinalizer(f resource.Finalizer) ReconcilerOption {
 	}
 }
 
+// WithFinalizerName configures the Reconciler to add and remove a finalizer
+// with the supplied name, rather than FinalizerName, to and from the managed
+// resource. This allows several controllers to reconcile the same kind of
+// managed resource without their finalizers conflicting.
+func WithFinalizerName(name string) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.managed.Finalizer = resource.NewAPIFinalizer(r.client, name)
+	}
+}
+
 // WithReferenceResolver specifies how the Reconciler should resolve any
 // inter-resource references it encounters while reconciling managed resources.
 func WithReferenceResolver(rr ReferenceResolver) ReconcilerOption {
@@ -753,6 +1431,56 @@ func WithReferenceResolver(rr ReferenceResolver) ReconcilerOption {
 	}
 }
 
+// WithConnectionDetailsResolver specifies how the Reconciler should resolve
+// connection details that managed resources reference from other resources.
+// Resolved connection details are available to the ExternalConnecter and the
+// ExternalClient via ReferencedConnectionDetailsFrom. Failing to resolve them
+// blocks reconciliation, except when the managed resource is being deleted. The
+// Reconciler doesn't resolve referenced connection details by default.
+func WithConnectionDetailsResolver(cr ConnectionDetailsResolver) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.connectionDetails = cr
+	}
+}
+
+// WithReferenceResolutionPolicy specifies when the Reconciler should resolve
+// inter-resource references. It configures the Reconciler to use an
+// APISimpleReferenceResolver with the supplied policy, replacing any
+// ReferenceResolver supplied by an earlier WithReferenceResolver option. It
+// composes with WithReferenceCycleDetection.
+func WithReferenceResolutionPolicy(p ReferenceResolutionPolicy) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.managed.ReferenceResolver = r.apiSimpleReferenceResolver(WithResolutionPolicy(p))
+	}
+}
+
+// WithReferenceCycleDetection configures the Reconciler to detect when a
+// managed resource can't resolve its references because it and a resource it
+// references reference each other. See WithCycleDetection. It configures the
+// Reconciler to use an APISimpleReferenceResolver that detects cycles,
+// replacing any ReferenceResolver supplied by an earlier WithReferenceResolver
+// option. It composes with WithReferenceResolutionPolicy.
+func WithReferenceCycleDetection() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.managed.ReferenceResolver = r.apiSimpleReferenceResolver(WithCycleDetection())
+	}
+}
+
+// apiSimpleReferenceResolver returns an APISimpleReferenceResolver configured
+// with the supplied options. It preserves the configuration of the Reconciler's
+// current ReferenceResolver, if it's an APISimpleReferenceResolver.
+func (r *Reconciler) apiSimpleReferenceResolver(o ...APISimpleReferenceResolverOption) *APISimpleReferenceResolver {
+	a, ok := r.managed.ReferenceResolver.(*APISimpleReferenceResolver)
+	if !ok {
+		return NewAPISimpleReferenceResolver(r.client, o...)
+	}
+	c := *a
+	for _, fn := range o {
+		fn(&c)
+	}
+	return &c
+}
+
 // WithLogger specifies how the Reconciler should log messages.
 func WithLogger(l logging.Logger) ReconcilerOption {
 	return func(r *Reconciler) {
@@ -782,6 +1510,17 @@ func WithReconcilerSupportedManagementPolicies(supported []sets.Set[xpv1.Managem
 	}
 }
 
+// WithDefaultManagementPolicy configures the Reconciler to use the supplied
+// management policies for managed resources that don't specify any. By default
+// a managed resource with empty management policies is paused when management
+// policies are enabled. The default is applied in-memory; it's never written to
+// the managed resource's spec.
+func WithDefaultManagementPolicy(p xpv1.ManagementPolicies) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.defaultManagementPolicies = p
+	}
+}
+
 // WithChangeLogger enables support for capturing change logs during
 // reconciliation.
 func WithChangeLogger(c ChangeLogger) ReconcilerOption {
@@ -790,6 +1529,16 @@ func WithChangeLogger(c ChangeLogger) ReconcilerOption {
 	}
 }
 
+// WithReconcilerID identifies the controller instance running the reconciler.
+// The ID is included as a controller-id value in every log line, as an
+// annotation on every recorded event, and in the additional details of every
+// change log entry. No ID is included by default.
+func WithReconcilerID(id string) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.id = id
+	}
+}
+
 // NewReconciler returns a Reconciler that reconciles managed resources of the
 // supplied ManagedKind with resources in an external system such as a cloud
 // provider API. It panics if asked to reconcile a managed resource kind that is
@@ -803,17 +1552,32 @@ func NewReconciler(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOp
 		return resource.MustCreateObject(schema.GroupVersionKind(of), m.GetScheme()).(resource.Managed)
 	}
 
-	// Panic early if we've been asked to reconcile a resource kind that has not
-	// been registered with our controller manager's scheme.
-	_ = nm()
-
 	r := &Reconciler{
 		client:                      m.GetClient(),
 		newManaged:                  nm,
 		pollInterval:                defaultPollInterval,
 		pollIntervalHook:            defaultPollIntervalHook,
+		deletionPolicyHook:          WaitForExternalDeletion,
+		preReconcileHook:            defaultPreReconcileHook,
+		postReconcileHook:           defaultPostReconcileHook,
+		resultHook:                  defaultResultHook,
+		lease:                       NopLeaseManager{},
+		statusUpdateStrategy:        StatusUpdateAlways,
+		contextDecorator:            defaultContextDecorator,
+		gvk:                         schema.GroupVersionKind(of),
+		tracer:                      defaultTracer(),
+		usage:                       resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+		staleConditionsHook:         defaultStaleConditionsHook,
+		mutator:                     defaultManagedMutator,
+		specValidator:               defaultSpecValidator,
+		concurrency:                 nopConcurrencyController{},
+		connectionDetails:           ConnectionDetailsResolverFn(func(_ context.Context, _ resource.Managed) (ConnectionDetails, error) { return nil, nil }),
 		creationGracePeriod:         defaultGracePeriod,
+		immediateCreateRequeue:      true,
+		permissionErrorPolicy:       RequeueWithBackoff,
+		initializerErrorHandler:     RequeueUnlessTerminal,
 		timeout:                     reconcileTimeout,
+		clock:                       clock.RealClock{},
 		managed:                     defaultMRManaged(m),
 		external:                    defaultMRExternal(),
 		supportedManagementPolicies: defaultSupportedManagementPolicies(),
@@ -827,6 +1591,24 @@ func NewReconciler(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOp
 		ro(r)
 	}
 
+	if r.syncEvents != nil {
+		r.syncEvents.now = r.clock.Now
+	}
+
+	// Panic early if we've been asked to reconcile a resource kind that has not
+	// been registered with our controller manager's scheme. This is done after
+	// all options are applied in case we were supplied a managed object
+	// factory.
+	_ = r.newManaged()
+
+	// The ID is applied after all options so that it's included regardless of
+	// the order in which the logger, recorder, and change logger were set.
+	if r.id != "" {
+		r.log = r.log.WithValues(keyControllerID, r.id)
+		r.record = r.record.WithAnnotations(keyControllerID, r.id)
+		r.change = &identifiedChangeLogger{wrapped: r.change, id: r.id}
+	}
+
 	return r
 }
 
@@ -835,55 +1617,176 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	// NOTE(negz): This method is a well over our cyclomatic complexity goal.
 	// Be wary of adding additional complexity.
 
+	// Our post-reconcile and result hooks observe the final result of the
+	// reconcile, so they must be deferred first in order to run last.
+	managed := r.newManaged()
+	exists := false
+	defer func(ctx context.Context) {
+		if !exists {
+			r.resultHook(ctx, req, nil, result, err)
+			return
+		}
+		r.resultHook(ctx, req, managed, result, err)
+	}(ctx)
+	defer func(ctx context.Context) {
+		if exists {
+			r.postReconcileHook(ctx, managed, result, err)
+		}
+	}(ctx)
+
 	defer func() { result, err = errors.SilentlyRequeueOnConflict(result, err) }()
 
+	// If we could only partially resolve our references due to a reference
+	// cycle we proceed, but requeue rather than waiting for our poll interval
+	// so that we can finish resolving them once the referenced resource is
+	// ready.
+	cyclic := false
+	defer func() {
+		if cyclic && err == nil && result.RequeueAfter > 0 {
+			result = reconcile.Result{Requeue: true}
+		}
+	}()
+
 	log := r.log.WithValues("request", req)
 	log.Debug("Reconciling")
 
+	// We log why we're requeueing once the reconcile is finished, derived
+	// from its final state. This is deferred last so that it observes the
+	// error we return before conflicts are silently requeued.
+	var reconciled requeueReason
+	defer func() {
+		if !exists && err == nil {
+			return
+		}
+		log.Debug("Reconcile finished", "requeue-reason", deriveRequeueReason(managed, reconciled, err))
+	}()
+
+	// Wait before we reconcile if we've been asked to slow down, for example
+	// because the external system is throttling us.
+	r.waitForConcurrency(ctx)
+
 	ctx, cancel := context.WithTimeout(ctx, r.timeout+reconcileGracePeriod)
 	defer cancel()
 
 	externalCtx, externalCancel := context.WithTimeout(ctx, r.timeout)
 	defer externalCancel()
 
-	managed := r.newManaged()
 	if err := r.client.Get(ctx, req.NamespacedName, managed); err != nil {
 		// There's no need to requeue if we no longer exist. Otherwise we'll be
 		// requeued implicitly because we return an error.
 		log.Debug("Cannot get managed resource", "error", err)
 		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
 	}
+	exists = true
+
+	// Managed resources that record when they were last reconciled do so only
+	// if their status changed, otherwise the time alone would change it.
+	var status client.SubResourceWriter = &lastReconcileTimeStatusWriter{SubResourceWriter: r.client.Status(), clock: r.clock}
+	if r.statusUpdateStrategy == StatusUpdateIfChanged {
+		status = newIfChangedStatusWriter(status, managed)
+	}
+	// Managed resources that record the generation they last synced must do
+	// so before we determine whether their status changed.
+	status = &lastSyncedGenerationStatusWriter{SubResourceWriter: status}
+	if r.conditionTransformer != nil {
+		// Conditions must be transformed before we determine whether the
+		// status changed.
+		status = newConditionTransformingStatusWriter(status, managed, r.conditionTransformer, r.clock)
+	}
 
 	r.metricRecorder.recordFirstTimeReconciled(managed)
 
-	record := r.record.WithAnnotations("external-name", meta.GetExternalName(managed))
+	record := r.record.WithAnnotations("external-name", resource.GetExternalName(managed))
 	log = log.WithValues(
 		"uid", managed.GetUID(),
 		"version", managed.GetResourceVersion(),
-		"external-name", meta.GetExternalName(managed),
+		"external-name", resource.GetExternalName(managed),
 	)
 
+	acquired, err := r.lease.Acquire(ctx, managed)
+	if err != nil {
+		log.Debug("Cannot acquire lease on managed resource", "error", err)
+		return reconcile.Result{}, errors.Wrap(err, errAcquireLease)
+	}
+	if !acquired {
+		// Another replica is reconciling this managed resource. We try again
+		// shortly rather than waiting for our poll interval, in case the
+		// other replica stops before it finishes.
+		log.Debug("Lease on managed resource is held by another replica", "requeue-after", r.clock.Now().Add(defaultLeaseRetryInterval))
+		return reconcile.Result{RequeueAfter: defaultLeaseRetryInterval}, nil
+	}
+	defer func() {
+		// The reconcile context may have expired by the time we release our
+		// lease, but we still want to release it so that other replicas
+		// don't have to wait for it to expire.
+		rctx, rcancel := context.WithTimeout(context.WithoutCancel(ctx), leaseReleaseTimeout)
+		defer rcancel()
+		if err := r.lease.Release(rctx, managed); err != nil {
+			log.Debug("Cannot release lease on managed resource", "error", err)
+		}
+	}()
+
+	if err := r.preReconcileHook(ctx, managed); err != nil {
+		// If this is the first time we encounter this issue we'll be requeued
+		// implicitly when we update our status with the new error condition.
+		// If not, we requeue explicitly, which will trigger backoff.
+		log.Debug("Cannot run pre-reconcile hook", "error", err)
+		if kerrors.IsConflict(err) {
+			return reconcile.Result{Requeue: true}, nil
+		}
+		record.Event(managed, event.Warning(reasonCannotPreReconcile, err))
+		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcilePreHook)))
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+	}
+
 	managementPoliciesEnabled := r.features.Enabled(feature.EnableBetaManagementPolicies)
+	managementPolicies := managed.GetManagementPolicies()
+	if len(managementPolicies) == 0 && len(r.defaultManagementPolicies) > 0 {
+		managementPolicies = r.defaultManagementPolicies
+	}
 	if managementPoliciesEnabled {
-		log.WithValues("managementPolicies", managed.GetManagementPolicies())
+		log.WithValues("managementPolicies", managementPolicies)
 	}
 
 	// Create the management policy resolver which will assist us in determining
 	// what actions to take on the managed resource based on the management
 	// and deletion policies.
-	policy := NewManagementPoliciesResolver(managementPoliciesEnabled, managed.GetManagementPolicies(), managed.GetDeletionPolicy(), WithSupportedManagementPolicies(r.supportedManagementPolicies))
+	policy := NewManagementPoliciesResolver(managementPoliciesEnabled, managementPolicies, managed.GetDeletionPolicy(), WithSupportedManagementPolicies(r.supportedManagementPolicies))
+	externalCtx = withAllowedActions(externalCtx, policy)
 
 	// Check if the resource has paused reconciliation based on the
 	// annotation or the management policies.
 	// Log, publish an event and update the SYNC status condition.
-	if meta.IsPaused(managed) || policy.IsPaused() {
-		log.Debug("Reconciliation is paused either through the `spec.managementPolicies` or the pause annotation", "annotation", meta.AnnotationKeyReconciliationPaused)
-		record.Event(managed, event.Normal(reasonReconciliationPaused, "Reconciliation is paused either through the `spec.managementPolicies` or the pause annotation",
-			"annotation", meta.AnnotationKeyReconciliationPaused))
-		managed.SetConditions(xpv1.ReconcilePaused())
+	if r.globalPause != nil && r.globalPause() {
+		msg := pausedMessage(managed, msgPausedGlobally)
+		log.Debug(msg)
+		record.Event(managed, event.Normal(reasonReconciliationPaused, msg))
+		managed.SetConditions(xpv1.ReconcilePaused().WithMessage(msg))
+		// Nothing will notify us when the global pause ends, so we poll to
+		// find out.
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{RequeueAfter: r.requeueAfter(managed, 0)})
+	}
+
+	if now := r.clock.Now(); meta.IsPausedAt(managed, now) || policy.IsPaused() {
+		cause := msgPausedByPolicies
+		switch {
+		case meta.IsPaused(managed):
+			cause = msgPausedByAnnotation
+		case meta.IsPausedAt(managed, now):
+			cause = fmt.Sprintf(msgFmtPausedUntil, meta.GetPausedUntil(managed).Format(time.RFC3339))
+		}
+		msg := pausedMessage(managed, cause)
+		log.Debug(msg, "annotation", meta.AnnotationKeyReconciliationPaused)
+		record.Event(managed, event.Normal(reasonReconciliationPaused, msg, "annotation", meta.AnnotationKeyReconciliationPaused))
+		managed.SetConditions(xpv1.ReconcilePaused().WithMessage(msg))
 		// if the pause annotation is removed or the management policies changed, we will have a chance to reconcile
 		// again and resume and if status update fails, we will reconcile again to retry to update the status
-		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		result := reconcile.Result{}
+		if !meta.IsPaused(managed) && !policy.IsPaused() {
+			// We're only paused until a particular time. Resume then.
+			result.RequeueAfter = meta.GetPausedUntil(managed).Sub(now)
+		}
+		return updateStatusAndReturn(ctx, status, managed, result)
 	}
 
 	// Check if the ManagementPolicies is set to a non-default value while the
@@ -901,13 +1804,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		}
 		record.Event(managed, event.Warning(reasonManagementPolicyInvalid, err))
 		managed.SetConditions(xpv1.ReconcileError(err))
-		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{})
 	}
 
 	// If managed resource has a deletion timestamp and a deletion policy of
 	// Orphan, we do not need to observe the external resource before attempting
-	// to unpublish connection details and remove finalizer.
-	if meta.WasDeleted(managed) && !policy.ShouldDelete() {
+	// to unpublish connection details and remove finalizer. Unless we've been
+	// asked to, in which case we connect and observe below, then skip deletion
+	// of the external resource.
+	if meta.WasDeleted(managed) && !policy.ShouldDelete() && !r.observeOnOrphanDelete {
 		log = log.WithValues("deletion-timestamp", managed.GetDeletionTimestamp())
 
 		// Empty ConnectionDetails are passed to UnpublishConnection because we
@@ -926,7 +1831,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			}
 			record.Event(managed, event.Warning(reasonCannotUnpublish, err))
 			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 		if err := r.managed.RemoveFinalizer(ctx, managed); err != nil {
 			// If this is the first time we encounter this issue we'll be
@@ -938,7 +1843,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 				return reconcile.Result{Requeue: true}, nil
 			}
 			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 
 		// We've successfully unpublished our managed resource's connection
@@ -950,17 +1855,78 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		return reconcile.Result{Requeue: false}, nil
 	}
 
+	// If we previously failed terminally we don't try again until the managed
+	// resource's spec changes, which increments its generation. We don't do
+	// this when the managed resource has been deleted, because we still need
+	// to try to delete the external resource.
+	if !meta.WasDeleted(managed) && meta.GetTerminalErrorGeneration(managed) != 0 {
+		if meta.TerminalErrorAtCurrentGeneration(managed) {
+			log.Debug("Skipping reconcile because the managed resource failed terminally at its current generation", "generation", managed.GetGeneration())
+			return reconcile.Result{}, nil
+		}
+
+		// The spec changed since we failed terminally. Clear the terminal
+		// state and try again.
+		//nolint:forcetypeassert // managed.DeepCopyObject() will always be a resource.Managed.
+		original := managed.DeepCopyObject().(resource.Managed)
+		meta.RemoveAnnotations(managed, meta.AnnotationKeyTerminalErrorGeneration)
+		if err := r.managed.UpdateManaged(ctx, original, managed); err != nil {
+			log.Debug(errUpdateManaged, "error", err)
+			if kerrors.IsConflict(err) {
+				return reconcile.Result{Requeue: true}, nil
+			}
+			record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManaged)))
+			managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+		}
+	}
+
 	if err := r.managed.Initialize(ctx, managed); err != nil {
 		// If this is the first time we encounter this issue we'll be requeued
 		// implicitly when we update our status with the new error condition. If
-		// not, we requeue explicitly, which will trigger backoff.
+		// not, we requeue explicitly, which will trigger backoff. We don't
+		// requeue explicitly if the error is one that retrying won't fix, for
+		// example because the managed resource's spec is invalid.
 		log.Debug("Cannot initialize managed resource", "error", err)
 		if kerrors.IsConflict(err) {
 			return reconcile.Result{Requeue: true}, nil
 		}
 		record.Event(managed, event.Warning(reasonCannotInitialize, err))
+		requeue := r.initializerErrorHandler(err)
+		if !requeue {
+			r.recordTerminalError(ctx, managed, log, record)
+		}
+		managed.SetConditions(xpv1.ReconcileError(err))
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: requeue})
+	}
+
+	if err := r.mutator(ctx, managed); err != nil {
+		// If this is the first time we encounter this issue we'll be requeued
+		// implicitly when we update our status with the new error condition. If
+		// not, we requeue explicitly, which will trigger backoff.
+		log.Debug("Cannot mutate managed resource", "error", err)
+		if kerrors.IsConflict(err) {
+			return reconcile.Result{Requeue: true}, nil
+		}
+		err = errors.Wrap(err, errMutateManaged)
+		record.Event(managed, event.Warning(reasonCannotMutate, err))
 		managed.SetConditions(xpv1.ReconcileError(err))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+	}
+
+	if !meta.WasDeleted(managed) {
+		if err := r.specValidator(ctx, managed); err != nil {
+			// There's no point calling the external system with a spec we
+			// know to be invalid, or retrying until the spec changes. We
+			// don't validate deleted managed resources, because we still
+			// need to delete their external resources.
+			log.Debug("Invalid managed resource spec", "error", err)
+			err = errors.Wrap(err, errValidateSpec)
+			record.Event(managed, event.Warning(reasonInvalidSpec, err))
+			r.recordTerminalError(ctx, managed, log, record)
+			managed.SetConditions(xpv1.ReconcileError(err))
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{})
+		}
 	}
 
 	// If we started but never completed creation of an external resource we
@@ -971,7 +1937,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug(errCreateIncomplete)
 		record.Event(managed, event.Warning(reasonCannotInitialize, errors.New(errCreateIncomplete)))
 		managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.New(errCreateIncomplete)))
-		return reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: false})
 	}
 
 	// We resolve any references before observing our external resource because
@@ -984,7 +1950,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	// impossible) that we need to resolve a reference in order to process a
 	// delete, and that reference is stale at delete time.
 	if !meta.WasDeleted(managed) {
-		if err := r.managed.ResolveReferences(ctx, managed); err != nil {
+		err := r.resolveReferences(ctx, managed)
+		if IsCyclicReference(err) {
+			log.Debug("Cannot fully resolve cyclic managed resource references", "error", err)
+			record.Event(managed, event.Warning(reasonCannotResolveRefs, err))
+			cyclic, err = true, nil
+		}
+		if err != nil {
 			// If any of our referenced resources are not yet ready (or if we
 			// encountered an error resolving them) we want to try again. If
 			// this is the first time we encounter this situation we'll be
@@ -996,11 +1968,61 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			}
 			record.Event(managed, event.Warning(reasonCannotResolveRefs, err))
 			managed.SetConditions(xpv1.ReconcileError(err))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			if r.resolvedRefsEvents {
+				managed.SetConditions(ReferencesUnresolved(err))
+			}
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+		}
+		if r.resolvedRefsEvents && !cyclic {
+			if referencesWereUnresolved(managed) {
+				record.Event(managed, event.Normal(reasonResolvedRefs, "Successfully resolved managed resource references"))
+			}
+			managed.SetConditions(ReferencesResolved())
+		}
+	}
+
+	// Connection details we reference from other resources block just like
+	// any other unresolved reference until they're available. We resolve them
+	// when being deleted too, because the ExternalClient may need them to
+	// delete the external resource. We don't block deletion on them though,
+	// because the resources we reference may also be being deleted.
+	cd, err := r.connectionDetails.ResolveConnectionDetails(ctx, managed)
+	switch {
+	case err != nil && meta.WasDeleted(managed):
+		log.Debug("Cannot resolve referenced connection details of deleted managed resource", "error", err)
+		record.Event(managed, event.Warning(reasonCannotResolveRefs, errors.Wrap(err, errResolveConnectionDetails)))
+	case err != nil:
+		log.Debug("Cannot resolve referenced connection details", "error", err)
+		if kerrors.IsConflict(err) {
+			return reconcile.Result{Requeue: true}, nil
+		}
+		err = errors.Wrap(err, errResolveConnectionDetails)
+		record.Event(managed, event.Warning(reasonCannotResolveRefs, err))
+		managed.SetConditions(xpv1.ReconcileError(err))
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+	case cd != nil:
+		externalCtx = withReferencedConnectionDetails(externalCtx, cd)
+	}
+
+	// Track usage of our ProviderConfig before we use it to connect, so that
+	// it can't be deleted while we're using it.
+	if err := r.usage.Track(ctx, managed); err != nil {
+		// If this is the first time we encounter this issue we'll be requeued
+		// implicitly when we update our status with the new error condition. If
+		// not, we requeue explicitly, which will trigger backoff.
+		log.Debug("Cannot track provider config usage", "error", err)
+		if kerrors.IsConflict(err) {
+			return reconcile.Result{Requeue: true}, nil
 		}
+		record.Event(managed, event.Warning(reasonCannotTrackUsage, err))
+		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errTrackUsage)))
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 	}
 
-	external, err := r.external.Connect(externalCtx, managed)
+	externalCtx, externalCancel = r.decorateContext(externalCtx, managed)
+	defer externalCancel()
+
+	external, err := r.connect(externalCtx, managed)
 	if err != nil {
 		// We'll usually hit this case if our Provider or its secret are missing
 		// or invalid. If this is first time we encounter this issue we'll be
@@ -1012,8 +2034,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			return reconcile.Result{Requeue: true}, nil
 		}
 		record.Event(managed, event.Warning(reasonCannotConnect, err))
+		if resource.IsTerminal(err) {
+			r.recordTerminalError(ctx, managed, log, record)
+		}
 		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileConnect)))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		result := reconcile.Result{Requeue: !resource.IsTerminal(err)}
+		if resource.IsTerminal(err) && meta.WasDeleted(managed) {
+			result = r.terminalDeletionResult(managed)
+		}
+		return updateStatusAndReturn(ctx, status, managed, result)
 	}
 	defer func() {
 		if err := r.external.Disconnect(ctx); err != nil {
@@ -1027,7 +2056,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		}
 	}()
 
-	observation, err := external.Observe(externalCtx, managed)
+	// Observe may late initialize the managed resource, so we keep a copy of
+	// its original state in order to determine what changed.
+	//nolint:forcetypeassert // managed.DeepCopyObject() will always be a resource.Managed.
+	managedPreObserve := managed.DeepCopyObject().(resource.Managed)
+	observeCtx, observeCancel := phaseContext(externalCtx, r.observeTimeout)
+	observation, err := r.observe(observeCtx, external, managed)
+	observeCancel()
 	if err != nil {
 		// We'll usually hit this case if our Provider credentials are invalid
 		// or insufficient for observing the external resource type we're
@@ -1040,16 +2075,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			return reconcile.Result{Requeue: true}, nil
 		}
 		record.Event(managed, event.Warning(reasonCannotObserve, err))
+		if resource.IsTerminal(err) {
+			r.recordTerminalError(ctx, managed, log, record)
+		}
 		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileObserve)))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		result := reconcile.Result{Requeue: !resource.IsTerminal(err)}
+		if resource.IsTerminal(err) && meta.WasDeleted(managed) {
+			result = r.terminalDeletionResult(managed)
+		}
+		return updateStatusAndReturn(ctx, status, managed, result)
 	}
 
 	// In the observe-only mode, !observation.ResourceExists will be an error
 	// case, and we will explicitly return this information to the user.
-	if !observation.ResourceExists && policy.ShouldOnlyObserve() {
+	// We don't block deletion if the external resource is already gone.
+	if !observation.ResourceExists && policy.ShouldOnlyObserve() && !meta.WasDeleted(managed) {
 		record.Event(managed, event.Warning(reasonCannotObserve, errors.New(errExternalResourceNotExist)))
 		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(errors.New(errExternalResourceNotExist), errReconcileObserve)))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 	}
 
 	// If this resource has a non-zero creation grace period we want to wait
@@ -1057,7 +2100,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	// doesn't exist. This is because some external APIs are eventually
 	// consistent and may report that a recently created resource does not
 	// exist.
-	if !observation.ResourceExists && meta.ExternalCreateSucceededDuring(managed, r.creationGracePeriod) {
+	if !observation.ResourceExists && meta.ExternalCreateSucceededDuringAt(managed, r.creationGracePeriod, r.clock.Now()) {
 		log.Debug("Waiting for external resource existence to be confirmed")
 		record.Event(managed, event.Normal(reasonPending, "Waiting for external resource existence to be confirmed"))
 		return reconcile.Result{Requeue: true}, nil
@@ -1072,9 +2115,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	if meta.WasDeleted(managed) {
 		log = log.WithValues("deletion-timestamp", managed.GetDeletionTimestamp())
 
+		if observation.ResourceExists && observation.ResourceDeleting && policy.ShouldDelete() {
+			// Our external resource is already being deleted. There's no need
+			// to request its deletion again; we just wait for it to cease to
+			// exist. Once it no longer exists we'll skip this block on a
+			// subsequent reconcile and proceed to unpublish and finalize.
+			log.Debug("External resource is being deleted")
+			managed.SetConditions(xpv1.Deleting().WithMessage("Waiting for the external resource to be deleted"), xpv1.ReconcileSuccess())
+			r.staleConditionsHook(ctx, managed)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+		}
+
 		if observation.ResourceExists && policy.ShouldDelete() {
-			deletion, err := external.Delete(externalCtx, managed)
-			if err != nil {
+			deleteCtx, deleteCancel := phaseContext(externalCtx, r.deleteTimeout)
+			deletion, err := external.Delete(deleteCtx, managed)
+			deleteCancel()
+			orphan := err != nil && r.deletionGracePeriodExpired(managed)
+			if err != nil && !orphan {
 				// We'll hit this condition if we can't delete our external
 				// resource, for example if our provider credentials don't have
 				// access to delete it. If this is the first time we encounter
@@ -1086,24 +2143,62 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 					log.Info(errRecordChangeLog, "error", err)
 				}
 				record.Event(managed, event.Warning(reasonCannotDelete, err))
+				if r.deletionGracePeriod > 0 && meta.GetDeletionAttemptTime(managed).IsZero() {
+					// Record when deletion first failed, so we know when
+					// our deletion grace period expires.
+					meta.SetDeletionAttemptTime(managed, r.clock.Now())
+					if err := r.managed.UpdateCriticalAnnotations(ctx, managed); err != nil {
+						log.Debug(errUpdateManagedAnnotations, "error", err)
+						record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManagedAnnotations)))
+					}
+				}
 				managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(errors.Wrap(err, errReconcileDelete)))
-				return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+				result := reconcile.Result{Requeue: true}
+				if resource.IsTerminal(err) {
+					result = r.terminalDeletionResult(managed)
+				}
+				return updateStatusAndReturn(ctx, status, managed, result)
 			}
 
-			// We've successfully requested deletion of our external resource.
-			// We queue another reconcile after a short wait rather than
-			// immediately finalizing our delete in order to verify that the
-			// external resource was actually deleted. If it no longer exists
-			// we'll skip this block on the next reconcile and proceed to
-			// unpublish and finalize. If it still exists we'll re-enter this
-			// block and try again.
-			log.Debug("Successfully requested deletion of external resource")
-			if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_DELETE, nil, deletion.AdditionalDetails); err != nil {
-				log.Info(errRecordChangeLog, "error", err)
+			if orphan {
+				// We've been failing to delete our external resource for
+				// longer than our deletion grace period. We give up, and
+				// proceed to unpublish and finalize, orphaning the external
+				// resource.
+				log.Info("Deletion grace period expired. Orphaning external resource", "error", err, "deletion-grace-period", r.deletionGracePeriod)
+				record.Event(managed, event.Warning(reasonOrphaned, errors.Wrapf(err, errFmtOrphaned, r.deletionGracePeriod)))
+			}
+
+			if !orphan {
+				// We've successfully requested deletion of our external
+				// resource. Unless our deletion policy hook tells us
+				// otherwise we queue another reconcile after a short wait
+				// rather than immediately finalizing our delete in order to
+				// verify that the external resource was actually deleted. If
+				// it no longer exists we'll skip this block on the next
+				// reconcile and proceed to unpublish and finalize. If it
+				// still exists we'll re-enter this block and try again.
+				log.Debug("Successfully requested deletion of external resource")
+				if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_DELETE, nil, deletion.AdditionalDetails); err != nil {
+					log.Info(errRecordChangeLog, "error", err)
+				}
+				record.Event(managed, event.Normal(reasonDeleted, "Successfully requested deletion of external resource"))
+				if r.publishDeletionDetails && len(deletion.ConnectionDetails) > 0 {
+					if _, err := r.publishConnection(ctx, managed, deletion.ConnectionDetails); err != nil {
+						// The external resource is already being deleted, so
+						// we'll likely not be called to Delete it again. We
+						// don't block deletion on publishing its details.
+						log.Debug("Cannot publish deletion connection details", "error", err)
+						record.Event(managed, event.Warning(reasonCannotPublish, err))
+					}
+				}
+				if r.deletionPolicyHook(managed) {
+					managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileSuccess())
+					r.staleConditionsHook(ctx, managed)
+					return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+				}
+				log.Debug("Not waiting for external resource to be deleted before removing finalizer")
 			}
-			record.Event(managed, event.Normal(reasonDeleted, "Successfully requested deletion of external resource"))
-			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileSuccess())
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 		if err := r.managed.UnpublishConnection(ctx, managed, observation.ConnectionDetails); err != nil {
 			// If this is the first time we encounter this issue we'll be
@@ -1116,7 +2211,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			}
 			record.Event(managed, event.Warning(reasonCannotUnpublish, err))
 			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 		if err := r.managed.RemoveFinalizer(ctx, managed); err != nil {
 			// If this is the first time we encounter this issue we'll be
@@ -1128,7 +2223,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 				return reconcile.Result{Requeue: true}, nil
 			}
 			managed.SetConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 
 		// We've successfully deleted our external resource (if necessary) and
@@ -1140,7 +2235,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		return reconcile.Result{Requeue: false}, nil
 	}
 
-	if _, err := r.managed.PublishConnection(ctx, managed, observation.ConnectionDetails); err != nil {
+	published, err := r.publishConnection(ctx, managed, observation.ConnectionDetails)
+	if err != nil {
 		// If this is the first time we encounter this issue we'll be requeued
 		// implicitly when we update our status with the new error condition. If
 		// not, we requeue explicitly, which will trigger backoff.
@@ -1150,7 +2246,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		}
 		record.Event(managed, event.Warning(reasonCannotPublish, err))
 		managed.SetConditions(xpv1.ReconcileError(err))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 	}
 
 	if err := r.managed.AddFinalizer(ctx, managed); err != nil {
@@ -1162,10 +2258,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			return reconcile.Result{Requeue: true}, nil
 		}
 		managed.SetConditions(xpv1.ReconcileError(err))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 	}
 
 	if !observation.ResourceExists && policy.ShouldCreate() {
+		if err := r.validateExternalName(managed); err != nil {
+			// There's no point creating an external resource that the
+			// provider will reject, or retrying until the external name
+			// changes.
+			log.Debug("Invalid external name", "error", err)
+			record.Event(managed, event.Warning(reasonCannotCreate, err))
+			r.recordTerminalError(ctx, managed, log, record)
+			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errReconcileCreate)))
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{})
+		}
+
 		// We write this annotation for two reasons. Firstly, it helps
 		// us to detect the case in which we fail to persist critical
 		// information (like the external name) that may be set by the
@@ -1173,7 +2280,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// we're operating on the latest version of our resource. We
 		// don't use the CriticalAnnotationUpdater because we _want_ the
 		// update to fail if we get a 409 due to a stale version.
-		meta.SetExternalCreatePending(managed, time.Now())
+		meta.SetExternalCreatePending(managed, r.clock.Now())
 		if err := r.client.Update(ctx, managed); err != nil {
 			log.Debug(errUpdateManaged, "error", err)
 			if kerrors.IsConflict(err) {
@@ -1181,10 +2288,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			}
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManaged)))
 			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 
-		creation, err := external.Create(externalCtx, managed)
+		createCtx, createCancel := phaseContext(externalCtx, r.createTimeout)
+		creation, err := external.Create(createCtx, managed)
+		createCancel()
 		if err != nil {
 			// We'll hit this condition if we can't create our external
 			// resource, for example if our provider credentials don't have
@@ -1202,7 +2311,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			// the reconciler will refuse to proceed, because it
 			// won't know whether or not it created an external
 			// resource.
-			meta.SetExternalCreateFailed(managed, time.Now())
+			meta.SetExternalCreateFailed(managed, r.clock.Now())
+			if resource.IsTerminal(err) {
+				meta.SetTerminalErrorGeneration(managed, managed.GetGeneration())
+			}
 			if err := r.managed.UpdateCriticalAnnotations(ctx, managed); err != nil {
 				log.Debug(errUpdateManagedAnnotations, "error", err)
 				record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManagedAnnotations)))
@@ -1217,13 +2329,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_CREATE, err, creation.AdditionalDetails); err != nil {
 				log.Info(errRecordChangeLog, "error", err)
 			}
+			if r.stopOnPermissionError(err) {
+				// Retrying is pointless until the managed resource's spec
+				// or the provider's credentials change.
+				log.Debug("Not requeueing because the provider has insufficient permissions to create the external resource")
+				managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(errors.Wrap(err, errReconcileCreate), errInsufficientPermissions)))
+				return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: false})
+			}
 			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errReconcileCreate)))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: !resource.IsTerminal(err)})
 		}
 
+		reconciled = requeueReasonCreated
+
 		// In some cases our external-name may be set by Create above.
-		log = log.WithValues("external-name", meta.GetExternalName(managed))
-		record = r.record.WithAnnotations("external-name", meta.GetExternalName(managed))
+		log = log.WithValues("external-name", resource.GetExternalName(managed))
+		record = r.record.WithAnnotations("external-name", resource.GetExternalName(managed))
 
 		if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_CREATE, nil, creation.AdditionalDetails); err != nil {
 			log.Info(errRecordChangeLog, "error", err)
@@ -1239,7 +2360,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// reverted when annotations are updated; at the time of writing
 		// Create implementations are advised not to alter status, but
 		// we may revisit this in future.
-		meta.SetExternalCreateSucceeded(managed, time.Now())
+		meta.SetExternalCreateSucceeded(managed, r.clock.Now())
 		if err := r.managed.UpdateCriticalAnnotations(ctx, managed); err != nil {
 			log.Debug(errUpdateManagedAnnotations, "error", err)
 			if kerrors.IsConflict(err) {
@@ -1247,10 +2368,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			}
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManagedAnnotations)))
 			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errUpdateManagedAnnotations)))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 
-		if _, err := r.managed.PublishConnection(ctx, managed, creation.ConnectionDetails); err != nil {
+		if err := r.publishOperationConnection(ctx, managed, observation.ConnectionDetails, creation.ConnectionDetails); err != nil {
 			// If this is the first time we encounter this issue we'll be
 			// requeued implicitly when we update our status with the new error
 			// condition. If not, we requeue explicitly, which will trigger backoff.
@@ -1260,17 +2381,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			}
 			record.Event(managed, event.Warning(reasonCannotPublish, err))
 			managed.SetConditions(xpv1.Creating(), xpv1.ReconcileError(err))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 
 		// We've successfully created our external resource. In many cases the
 		// creation process takes a little time to finish. We requeue explicitly
 		// order to observe the external resource to determine whether it's
-		// ready for use.
+		// ready for use, unless we've been configured not to or Create told us
+		// when to observe it.
 		log.Debug("Successfully requested creation of external resource")
 		record.Event(managed, event.Normal(reasonCreated, "Successfully requested creation of external resource"))
 		managed.SetConditions(xpv1.Creating(), xpv1.ReconcileSuccess())
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		r.staleConditionsHook(ctx, managed)
+		if creation.RequeueAfter > 0 || !r.immediateCreateRequeue {
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{RequeueAfter: r.requeueAfter(managed, creation.RequeueAfter)})
+		}
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 	}
 
 	if observation.ResourceLateInitialized && policy.ShouldLateInitialize() {
@@ -1280,12 +2406,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// resource's status, which is subsequently deserialized into managed.
 		// This is usually tolerable because the update will implicitly requeue
 		// an immediate reconcile which should re-observe the external resource
-		// and persist its status.
-		if err := r.client.Update(ctx, managed); err != nil {
+		// and persist its status. A ConditionPreservingManagedUpdater avoids
+		// losing pending status conditions.
+		if err := r.managed.UpdateManaged(ctx, managedPreObserve, managed); err != nil {
 			log.Debug(errUpdateManaged, "error", err)
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, err))
 			managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 		}
 	}
 
@@ -1296,9 +2423,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// after the specified poll interval in order to observe it and react
 		// accordingly.
 		// https://github.com/crossplane/crossplane/issues/289
-		reconcileAfter := r.pollIntervalHook(managed, r.pollInterval)
-		log.Debug("External resource is up to date", "requeue-after", time.Now().Add(reconcileAfter))
+		reconcileAfter := r.requeueAfter(managed, observation.RequeueAfter)
+		log.Debug("External resource is up to date", "requeue-after", r.clock.Now().Add(reconcileAfter))
 		managed.SetConditions(xpv1.ReconcileSuccess())
+		r.staleConditionsHook(ctx, managed)
 		r.metricRecorder.recordFirstTimeReady(managed)
 
 		// record that we intentionally did not update the managed resource
@@ -1307,7 +2435,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// that the external object would not have been updated.
 		r.metricRecorder.recordUnchanged(managed.GetName())
 
-		return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		if r.syncEvents != nil && r.syncEvents.Due(managed.GetUID()) {
+			record.Event(managed, event.Normal(reasonInSync, "External resource is up to date"))
+		}
+
+		if published && r.requeueOnConnectionDetailsChange {
+			log.Debug("Connection details changed; requeueing immediately")
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
+		}
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{RequeueAfter: reconcileAfter})
 	}
 
 	if observation.Diff != "" {
@@ -1316,13 +2452,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 
 	// skip the update if the management policy is set to ignore updates
 	if !policy.ShouldUpdate() {
-		reconcileAfter := r.pollIntervalHook(managed, r.pollInterval)
-		log.Debug("Skipping update due to managementPolicies. Reconciliation succeeded", "requeue-after", time.Now().Add(reconcileAfter))
+		reconcileAfter := r.requeueAfter(managed, observation.RequeueAfter)
+		log.Debug("Skipping update due to managementPolicies. Reconciliation succeeded", "requeue-after", r.clock.Now().Add(reconcileAfter))
 		managed.SetConditions(xpv1.ReconcileSuccess())
-		return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		r.staleConditionsHook(ctx, managed)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{RequeueAfter: reconcileAfter})
 	}
 
-	update, err := external.Update(externalCtx, managed)
+	updateCtx, updateCancel := phaseContext(externalCtx, r.updateTimeout)
+	update, err := external.Update(updateCtx, managed)
+	updateCancel()
 	if err != nil {
 		// We'll hit this condition if we can't update our external resource,
 		// for example if our provider credentials don't have access to update
@@ -1334,24 +2473,36 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Info(errRecordChangeLog, "error", err)
 		}
 		record.Event(managed, event.Warning(reasonCannotUpdate, err))
+		if resource.IsTerminal(err) {
+			r.recordTerminalError(ctx, managed, log, record)
+		}
+		if r.stopOnPermissionError(err) {
+			// Retrying is pointless until the managed resource's spec or the
+			// provider's credentials change.
+			log.Debug("Not requeueing because the provider has insufficient permissions to update the external resource")
+			managed.SetConditions(xpv1.ReconcileError(errors.Wrap(errors.Wrap(err, errReconcileUpdate), errInsufficientPermissions)))
+			return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: false})
+		}
 		managed.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileUpdate)))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: !resource.IsTerminal(err)})
 	}
 
+	reconciled = requeueReasonUpdated
+
 	// record the drift after the successful update.
 	r.metricRecorder.recordDrift(managed)
 	if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_UPDATE, nil, update.AdditionalDetails); err != nil {
 		log.Info(errRecordChangeLog, "error", err)
 	}
 
-	if _, err := r.managed.PublishConnection(ctx, managed, update.ConnectionDetails); err != nil {
+	if err := r.publishOperationConnection(ctx, managed, observation.ConnectionDetails, update.ConnectionDetails); err != nil {
 		// If this is the first time we encounter this issue we'll be requeued
 		// implicitly when we update our status with the new error condition. If
 		// not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot publish connection details", "error", err)
 		record.Event(managed, event.Warning(reasonCannotPublish, err))
 		managed.SetConditions(xpv1.ReconcileError(err))
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		return updateStatusAndReturn(ctx, status, managed, reconcile.Result{Requeue: true})
 	}
 
 	// We've successfully updated our external resource. Per the below issue
@@ -1359,9 +2510,70 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	// changes, so we requeue a speculative reconcile after the specified poll
 	// interval in order to observe it and react accordingly.
 	// https://github.com/crossplane/crossplane/issues/289
-	reconcileAfter := r.pollIntervalHook(managed, r.pollInterval)
-	log.Debug("Successfully requested update of external resource", "requeue-after", time.Now().Add(reconcileAfter))
+	reconcileAfter := r.requeueAfter(managed, observation.RequeueAfter)
+	log.Debug("Successfully requested update of external resource", "requeue-after", r.clock.Now().Add(reconcileAfter))
 	record.Event(managed, event.Normal(reasonUpdated, "Successfully requested update of external resource"))
 	managed.SetConditions(xpv1.ReconcileSuccess())
-	return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	r.staleConditionsHook(ctx, managed)
+	return updateStatusAndReturn(ctx, status, managed, reconcile.Result{RequeueAfter: reconcileAfter})
+}
+
+// A requeueReason explains why a reconcile was requeued.
+type requeueReason string
+
+// Requeue reasons.
+const (
+	requeueReasonError    requeueReason = "error"
+	requeueReasonPoll     requeueReason = "poll"
+	requeueReasonCreated  requeueReason = "created"
+	requeueReasonUpdated  requeueReason = "updated"
+	requeueReasonDeleting requeueReason = "deleting"
+	requeueReasonPaused   requeueReason = "paused"
+)
+
+// deriveRequeueReason derives why a reconcile of the supplied managed resource
+// was requeued from its final state. The reconciled reason should be
+// requeueReasonCreated or requeueReasonUpdated if the reconcile successfully
+// created or updated the external resource, and empty otherwise.
+func deriveRequeueReason(mg resource.Managed, reconciled requeueReason, err error) requeueReason {
+	switch c := mg.GetCondition(xpv1.TypeSynced); {
+	case err != nil || c.Reason == xpv1.ReasonReconcileError:
+		return requeueReasonError
+	case c.Reason == xpv1.ReasonReconcilePaused:
+		return requeueReasonPaused
+	case meta.WasDeleted(mg):
+		return requeueReasonDeleting
+	case reconciled != "":
+		return reconciled
+	default:
+		return requeueReasonPoll
+	}
+}
+
+// validateExternalName validates the external name of the supplied managed
+// resource, if it has one and the Reconciler was configured to validate
+// external names.
+func (r *Reconciler) validateExternalName(mg resource.Managed) error {
+	name := resource.GetExternalName(mg)
+	if r.externalNameRules == nil || name == "" {
+		return nil
+	}
+	return meta.ValidateExternalName(name, *r.externalNameRules)
+}
+
+// recordTerminalError records that the supplied managed resource failed
+// terminally at its current generation, so that it won't be reconciled again
+// until its spec changes. Note that persisting the record may reset pending
+// changes to the managed resource's status.
+func (r *Reconciler) recordTerminalError(ctx context.Context, mg resource.Managed, log logging.Logger, record event.Recorder) {
+	if meta.WasDeleted(mg) {
+		return
+	}
+	meta.SetTerminalErrorGeneration(mg, mg.GetGeneration())
+	if err := r.managed.UpdateCriticalAnnotations(ctx, mg); err != nil {
+		// We only log and emit an event here because presumably it's more
+		// useful to set our status condition to the reason we failed.
+		log.Debug(errUpdateManagedAnnotations, "error", err)
+		record.Event(mg, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManagedAnnotations)))
+	}
 }
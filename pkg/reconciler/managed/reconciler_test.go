@@ -18,16 +18,22 @@ package managed
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	testingclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -35,6 +41,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/apis/changelogs/proto/v1alpha1"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
@@ -75,6 +83,35 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{err: errors.Wrap(errBoom, errGetManaged)},
 		},
+		"PreReconcileHookError": {
+			reason: "Errors returned by the pre-reconcile hook should prevent reconciliation and trigger a requeue after a short wait.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errReconcilePreHook)))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Errors returned by the pre-reconcile hook should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithPreReconcileHook(func(_ context.Context, _ resource.Managed) error { return errBoom }),
+					WithInitializers(InitializerFn(func(_ context.Context, _ resource.Managed) error {
+						t.Errorf("Reconcile should not proceed when the pre-reconcile hook returns an error")
+						return nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"ManagedNotFound": {
 			reason: "Not found errors encountered while getting the resource under reconciliation should be ignored.",
 			args: args{
@@ -204,6 +241,137 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"InitializeTerminalError": {
+			reason: "Terminal errors initializing the managed resource should not trigger a requeue.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							obj.SetGeneration(42)
+							return nil
+						}),
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetGeneration(42)
+							meta.SetTerminalErrorGeneration(want, 42)
+							want.SetConditions(xpv1.ReconcileError(resource.Terminal(errBoom)))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Terminal errors initializing the managed resource should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(InitializerFn(func(_ context.Context, _ resource.Managed) error {
+						return resource.Terminal(errBoom)
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{}},
+		},
+		"InitializeErrorHandlerNoRequeue": {
+			reason: "Errors initializing the managed resource should not trigger a requeue if the InitializerErrorHandler says so.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							obj.SetGeneration(42)
+							return nil
+						}),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(InitializerFn(func(_ context.Context, _ resource.Managed) error {
+						return errBoom
+					})),
+					WithInitializerErrorHandler(func(_ error) bool { return false }),
+				},
+			},
+			want: want{result: reconcile.Result{}},
+		},
+		"TerminalErrorAtCurrentGeneration": {
+			reason: "We should return early without requeueing if the managed resource failed terminally at its current generation.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							obj.SetGeneration(42)
+							meta.SetTerminalErrorGeneration(obj, 42)
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(InitializerFn(func(_ context.Context, _ resource.Managed) error {
+						t.Errorf("\nReason: We should not initialize a managed resource that failed terminally at its current generation.")
+						return nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{}},
+		},
+		"TerminalErrorAtPreviousGeneration": {
+			reason: "We should clear the terminal state and try again if the managed resource's generation changed since it failed terminally.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							obj.SetGeneration(43)
+							meta.SetTerminalErrorGeneration(obj, 42)
+							return nil
+						}),
+						MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+							if _, ok := obj.GetAnnotations()[meta.AnnotationKeyTerminalErrorGeneration]; ok {
+								t.Errorf("\nReason: The terminal error generation annotation should be removed when the generation changes.")
+							}
+							return nil
+						}),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetGeneration(43)
+							want.SetAnnotations(map[string]string{})
+							want.SetConditions(xpv1.ReconcileSuccess())
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A successful retry after a terminal error should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
 		"ExternalCreatePending": {
 			reason: "We should return early if the managed resource appears to be pending creation. We might have leaked a resource and don't want to create another.",
 			args: args{
@@ -328,6 +496,48 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
 		},
+		"ExternalConnectDisconnecterDisconnectError": {
+			reason: "Error disconnecting from the provider using a connect disconnecter should not trigger requeue.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ReconcileSuccess())
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A successful no-op reconcile should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnectDisconnecter(ExternalConnectDisconnecterFns{
+						ConnectFn: func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+							c := &ExternalClientFns{
+								ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+									return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+								},
+								DisconnectFn: func(_ context.Context) error { return nil },
+							}
+							return c, nil
+						},
+						DisconnectFn: func(_ context.Context) error {
+							return errBoom
+						},
+					}),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
 		"ExternalObserveError": {
 			reason: "Errors observing the external resource should trigger a requeue after a short wait.",
 			args: args{
@@ -491,6 +701,94 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"ExternalDeleteInProgress": {
+			reason: "A deleted managed resource whose external resource is already being deleted should wait for it to be deleted without requesting deletion again.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&now)
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+							return nil
+						}),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetDeletionTimestamp(&now)
+							want.SetDeletionPolicy(xpv1.DeletionDelete)
+							want.SetConditions(xpv1.ReconcileSuccess())
+							want.SetConditions(xpv1.Deleting().WithMessage("Waiting for the external resource to be deleted"))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "An external resource that is being deleted should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceDeleting: true}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+								t.Errorf("Delete should not be called for an external resource that is being deleted")
+								return ExternalDelete{}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"ExternalDeleteSuccessfulRemoveFinalizerAfterDeleteRequest": {
+			reason: "A deleted managed resource whose deletion policy hook doesn't wait for deletion should remove its finalizer as soon as it has requested deletion of its external resource.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&now)
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+								return ExternalDelete{}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithDeletionPolicyHook(RemoveFinalizerAfterDeleteRequest),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: false}},
+		},
 		"UnpublishConnectionDetailsDeletionPolicyDeleteError": {
 			reason: "Errors unpublishing connection details should trigger a requeue after a short wait.",
 			args: args{
@@ -775,21 +1073,26 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
-		"UpdateCriticalAnnotationsError": {
-			reason: "Errors updating critical annotations after creation should trigger a requeue after a short wait.",
+		"CreateExternalTerminalError": {
+			reason: "Terminal errors while creating an external resource should not trigger a requeue.",
 			args: args{
 				m: &fake.Manager{
 					Client: &test.MockClient{
-						MockGet:    test.NewMockGetFn(nil),
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							obj.SetGeneration(42)
+							return nil
+						}),
 						MockUpdate: test.NewMockUpdateFn(nil),
 						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
 							want := &fake.Managed{}
+							want.SetGeneration(42)
+							meta.SetTerminalErrorGeneration(want, 42)
 							meta.SetExternalCreatePending(want, time.Now())
-							meta.SetExternalCreateSucceeded(want, time.Now())
-							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errUpdateManagedAnnotations)))
+							meta.SetExternalCreateFailed(want, time.Now())
+							want.SetConditions(xpv1.ReconcileError(errors.Wrap(resource.Terminal(errBoom), errReconcileCreate)))
 							want.SetConditions(xpv1.Creating())
 							if diff := cmp.Diff(want, obj, test.EquateConditions(), cmpopts.EquateApproxTime(1*time.Second)); diff != "" {
-								reason := "Errors updating critical annotations after creation should be reported as a conditioned status."
+								reason := "Terminal errors while creating an external resource should be reported as a conditioned status."
 								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
 							}
 							return nil
@@ -807,7 +1110,7 @@ func TestReconciler(t *testing.T) {
 								return ExternalObservation{ResourceExists: false}, nil
 							},
 							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
-								return ExternalCreation{}, nil
+								return ExternalCreation{}, resource.Terminal(errBoom)
 							},
 							DisconnectFn: func(_ context.Context) error {
 								return nil
@@ -815,14 +1118,15 @@ func TestReconciler(t *testing.T) {
 						}
 						return c, nil
 					})),
+					WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+					WithConnectionPublishers(),
 					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
-					WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return errBoom })),
 				},
 			},
-			want: want{result: reconcile.Result{Requeue: true}},
+			want: want{result: reconcile.Result{}},
 		},
-		"PublishCreationConnectionDetailsError": {
-			reason: "Errors publishing connection details after creation should trigger a requeue after a short wait.",
+		"UpdateCriticalAnnotationsError": {
+			reason: "Errors updating critical annotations after creation should trigger a requeue after a short wait.",
 			args: args{
 				m: &fake.Manager{
 					Client: &test.MockClient{
@@ -832,7 +1136,93 @@ func TestReconciler(t *testing.T) {
 							want := &fake.Managed{}
 							meta.SetExternalCreatePending(want, time.Now())
 							meta.SetExternalCreateSucceeded(want, time.Now())
-							want.SetConditions(xpv1.ReconcileError(errBoom))
+							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errUpdateManagedAnnotations)))
+							want.SetConditions(xpv1.Creating())
+							if diff := cmp.Diff(want, obj, test.EquateConditions(), cmpopts.EquateApproxTime(1*time.Second)); diff != "" {
+								reason := "Errors updating critical annotations after creation should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+								return ExternalCreation{}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return errBoom })),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"UpdateCriticalAnnotationsConflict": {
+			reason: "Conflicts updating critical annotations after creation should trigger an immediate requeue without updating status.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(nil),
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+							t.Errorf("\nReason: %s\nStatus should not be updated when critical annotations can't be updated due to a conflict.", "UpdateCriticalAnnotationsConflict")
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+								return ExternalCreation{}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error {
+						return kerrors.NewConflict(schema.GroupResource{}, "", errBoom)
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"PublishCreationConnectionDetailsError": {
+			reason: "Errors publishing connection details after creation should trigger a requeue after a short wait.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(nil),
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							meta.SetExternalCreatePending(want, time.Now())
+							meta.SetExternalCreateSucceeded(want, time.Now())
+							want.SetConditions(xpv1.ReconcileError(errBoom))
 							want.SetConditions(xpv1.Creating())
 							if diff := cmp.Diff(want, obj, test.EquateConditions(), cmpopts.EquateApproxTime(1*time.Second)); diff != "" {
 								reason := "Errors publishing connection details after creation should be reported as a conditioned status."
@@ -913,6 +1303,41 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"CreateSuccessfulWithoutImmediateRequeue": {
+			reason: "Successful managed resource creation should trigger a requeue after the poll interval if immediate create requeues are disabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(nil),
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							meta.SetExternalCreatePending(want, time.Now())
+							meta.SetExternalCreateSucceeded(want, time.Now())
+							want.SetConditions(xpv1.ReconcileSuccess())
+							want.SetConditions(xpv1.Creating())
+							if diff := cmp.Diff(want, obj, test.EquateConditions(), cmpopts.EquateApproxTime(1*time.Second)); diff != "" {
+								reason := "Successful managed resource creation should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(&NopConnecter{}),
+					WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+					WithConnectionPublishers(),
+					WithImmediateCreateRequeue(false),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
 		"LateInitializeUpdateError": {
 			reason: "Errors updating a managed resource to persist late initialized fields should trigger a requeue after a short wait.",
 			args: args{
@@ -992,6 +1417,47 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
 		},
+		"ExternalResourceUpToDateCyclicReferences": {
+			reason: "When references could only be partially resolved due to a cycle the reconcile should proceed, but be requeued.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetConditions(xpv1.ReconcileSuccess())
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A cyclic reference should not prevent a successful reconcile."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error {
+						return CyclicReferenceError{error: errBoom}
+					})),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"ExternalResourceUpToDateWithJitter": {
 			reason: "When the external resource exists and is up to date a requeue should be triggered after a long wait with jitter added.",
 			args: args{
@@ -1157,6 +1623,54 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"UpdateExternalTerminalError": {
+			reason: "Terminal errors while updating an external resource should not trigger a requeue.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							obj.SetGeneration(42)
+							return nil
+						}),
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetGeneration(42)
+							meta.SetTerminalErrorGeneration(want, 42)
+							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errors.Wrap(resource.ErrTerminal, errBoom.Error()), errReconcileUpdate)))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Terminal errors while updating an external resource should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								return ExternalUpdate{}, errors.Wrap(resource.ErrTerminal, errBoom.Error())
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{}},
+		},
 		"PublishUpdateConnectionDetailsError": {
 			reason: "Errors publishing connection details after an update should trigger a requeue after a short wait.",
 			args: args{
@@ -1309,7 +1823,7 @@ func TestReconciler(t *testing.T) {
 						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
 							want := &fake.Managed{}
 							want.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
-							want.SetConditions(xpv1.ReconcilePaused())
+							want.SetConditions(xpv1.ReconcilePaused().WithMessage(msgPausedByAnnotation))
 							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
 								reason := `If managed resource has the pause annotation with value "true", it should acquire "Synced" status condition with the status "False" and the reason "ReconcilePaused".`
 								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
@@ -1336,7 +1850,7 @@ func TestReconciler(t *testing.T) {
 						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
 							want := &fake.Managed{}
 							want.SetManagementPolicies(xpv1.ManagementPolicies{})
-							want.SetConditions(xpv1.ReconcilePaused())
+							want.SetConditions(xpv1.ReconcilePaused().WithMessage(msgPausedByPolicies))
 							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
 								reason := `If managed resource has the pause annotation with value "true", it should acquire "Synced" status condition with the status "False" and the reason "ReconcilePaused".`
 								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
@@ -1686,6 +2200,47 @@ func TestReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"DefaultManagementPolicyCreateSuccessful": {
+			reason: "A managed resource with empty management policies should use the default policies, rather than being paused.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := obj.(*fake.Managed)
+							mg.SetManagementPolicies(xpv1.ManagementPolicies{})
+							return nil
+						}),
+						MockUpdate: test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := &fake.Managed{}
+							want.SetManagementPolicies(xpv1.ManagementPolicies{})
+							meta.SetExternalCreatePending(want, time.Now())
+							meta.SetExternalCreateSucceeded(want, time.Now())
+							want.SetConditions(xpv1.ReconcileSuccess())
+							want.SetConditions(xpv1.Creating())
+							if diff := cmp.Diff(want, obj, test.EquateConditions(), cmpopts.EquateApproxTime(1*time.Second)); diff != "" {
+								reason := "The default management policies should be applied in-memory, and the external resource should be created."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithManagementPolicies(),
+					WithDefaultManagementPolicy(xpv1.ManagementPolicies{xpv1.ManagementActionAll}),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnecter(&NopConnecter{}),
+					WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+					WithConnectionPublishers(),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"ManagementPolicyCreateCreateSuccessful": {
 			reason: "Successful managed resource creation using management policy Create should trigger a requeue after a short wait.",
 			args: args{
@@ -1935,712 +2490,3810 @@ func TestReconciler(t *testing.T) {
 	}
 }
 
-func TestTestManagementPoliciesResolverIsPaused(t *testing.T) {
-	type args struct {
-		enabled bool
-		policy  xpv1.ManagementPolicies
+func TestReconcilerPostReconcileHook(t *testing.T) {
+	type observed struct {
+		called bool
+		result reconcile.Result
+		err    error
 	}
+
 	cases := map[string]struct {
 		reason string
-		args   args
-		want   bool
+		c      client.Client
+		want   observed
 	}{
-		"Disabled": {
-			reason: "Should return false if management policies are disabled",
-			args: args{
-				enabled: false,
-				policy:  xpv1.ManagementPolicies{},
-			},
-			want: false,
-		},
-		"EnabledEmptyPolicies": {
-			reason: "Should return true if the management policies are enabled and empty",
-			args: args{
-				enabled: true,
-				policy:  xpv1.ManagementPolicies{},
+		"ObservesFinalResult": {
+			reason: "The post-reconcile hook should observe the final result of the reconcile.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+				// Conflicts are silently requeued, so the final result should
+				// differ from the status update's error.
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(kerrors.NewConflict(schema.GroupResource{}, "", errors.New("boom"))),
 			},
-			want: true,
+			want: observed{called: true, result: reconcile.Result{Requeue: true}},
 		},
-		"EnabledNonEmptyPolicies": {
-			reason: "Should return true if the management policies are enabled and non empty",
-			args: args{
-				enabled: true,
-				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+		"ManagedNotFound": {
+			reason: "The post-reconcile hook should not be called if the managed resource does not exist.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
 			},
-			want: false,
+			want: observed{},
 		},
 	}
+
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewManagementPoliciesResolver(tc.args.enabled, tc.args.policy, xpv1.DeletionDelete)
-			if diff := cmp.Diff(tc.want, r.IsPaused()); diff != "" {
-				t.Errorf("\nReason: %s\nIsPaused(...): -want, +got:\n%s", tc.reason, diff)
+			got := observed{}
+			r := NewReconciler(&fake.Manager{Client: tc.c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithPreReconcileHook(func(_ context.Context, _ resource.Managed) error { return errors.New("boom") }),
+				WithPostReconcileHook(func(_ context.Context, _ resource.Managed, result reconcile.Result, err error) {
+					got = observed{called: true, result: result, err: err}
+				}),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(observed{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nPostReconcileHook(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if tc.want.called {
+				if diff := cmp.Diff(result, got.result); diff != "" {
+					t.Errorf("\nReason: %s\nr.Reconcile(...): -returned, +observed:\n%s", tc.reason, diff)
+				}
+				if diff := cmp.Diff(err, got.err, test.EquateErrors()); diff != "" {
+					t.Errorf("\nReason: %s\nr.Reconcile(...): -returned error, +observed error:\n%s", tc.reason, diff)
+				}
 			}
 		})
 	}
 }
 
-func TestManagementPoliciesResolverValidate(t *testing.T) {
-	type args struct {
-		enabled bool
-		policy  xpv1.ManagementPolicies
+type fakeLeaseManager struct {
+	acquired bool
+	err      error
+
+	released bool
+}
+
+func (m *fakeLeaseManager) Acquire(_ context.Context, _ resource.Managed) (bool, error) {
+	return m.acquired, m.err
+}
+
+func (m *fakeLeaseManager) Release(ctx context.Context, _ resource.Managed) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	m.released = true
+	return nil
+}
+
+func TestReconcilerResourceLease(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		result     reconcile.Result
+		err        error
+		reconciled bool
+		released   bool
 	}
+
 	cases := map[string]struct {
 		reason string
-		args   args
-		want   error
+		lm     *fakeLeaseManager
+		cancel bool
+		want   want
 	}{
-		"Enabled": {
-			reason: "Should return nil if the management policy is enabled.",
-			args: args{
-				enabled: true,
-				policy:  xpv1.ManagementPolicies{},
-			},
-			want: nil,
-		},
-		"DisabledNonDefault": {
-			reason: "Should return error if the management policy is non-default and disabled.",
-			args: args{
-				enabled: false,
-				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionCreate},
-			},
-			want: fmt.Errorf(errFmtManagementPolicyNonDefault, []xpv1.ManagementAction{xpv1.ManagementActionCreate}),
+		"Acquired": {
+			reason: "We should reconcile the managed resource and then release our lease if we acquire it.",
+			lm:     &fakeLeaseManager{acquired: true},
+			want:   want{result: reconcile.Result{Requeue: true}, reconciled: true, released: true},
 		},
-		"DisabledDefault": {
-			reason: "Should return nil if the management policy is default and disabled.",
-			args: args{
-				enabled: false,
-				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionAll},
-			},
-			want: nil,
+		"AcquiredContextDone": {
+			reason: "We should release our lease even if the reconcile context is done by the time we release it.",
+			lm:     &fakeLeaseManager{acquired: true},
+			cancel: true,
+			want:   want{result: reconcile.Result{Requeue: true}, reconciled: true, released: true},
 		},
-		"EnabledSupported": {
-			reason: "Should return nil if the management policy is supported.",
-			args: args{
-				enabled: true,
-				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionAll},
-			},
-			want: nil,
+		"Contended": {
+			reason: "We should requeue after a short wait without reconciling if another replica holds the lease.",
+			lm:     &fakeLeaseManager{acquired: false},
+			want:   want{result: reconcile.Result{RequeueAfter: defaultLeaseRetryInterval}},
 		},
-		"EnabledNotSupported": {
-			reason: "Should return err if the management policy is not supported.",
-			args: args{
-				enabled: true,
-				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionDelete},
-			},
-			want: fmt.Errorf(errFmtManagementPolicyNotSupported, []xpv1.ManagementAction{xpv1.ManagementActionDelete}),
+		"AcquireError": {
+			reason: "We should return any error encountered while acquiring the lease without reconciling.",
+			lm:     &fakeLeaseManager{err: errBoom},
+			want:   want{err: errors.Wrap(errBoom, errAcquireLease)},
 		},
 	}
+
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewManagementPoliciesResolver(tc.args.enabled, tc.args.policy, xpv1.DeletionDelete)
-			if diff := cmp.Diff(tc.want, r.Validate(), test.EquateErrors()); diff != "" {
-				t.Errorf("\nReason: %s\nIsNonDefault(...): -want, +got:\n%s", tc.reason, diff)
+			reconciled := false
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithResourceLease(tc.lm),
+				WithPreReconcileHook(func(_ context.Context, _ resource.Managed) error {
+					reconciled = true
+					if tc.cancel {
+						cancel()
+					}
+					return errBoom
+				}),
+			)
+			result, err := r.Reconcile(ctx, reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reconciled, reconciled); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want reconciled, +got reconciled:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.released, tc.lm.released); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want released, +got released:\n%s", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestManagementPoliciesResolverShouldCreate(t *testing.T) {
+func TestReconcilerStatusUpdateStrategy(t *testing.T) {
+	synced := func(obj client.Object) error {
+		obj.(*fake.Managed).SetConditions(xpv1.ReconcileSuccess())
+		return nil
+	}
+
 	type args struct {
-		managementPoliciesEnabled bool
-		policy                    xpv1.ManagementPolicies
+		get      test.ObjectFn
+		strategy StatusUpdateStrategy
 	}
+
 	cases := map[string]struct {
 		reason string
 		args   args
-		want   bool
+		want   int
 	}{
-		"ManagementPoliciesDisabled": {
-			reason: "Should return true if management policies are disabled",
-			args: args{
-				managementPoliciesEnabled: false,
-			},
-			want: true,
-		},
-		"ManagementPoliciesEnabledHasCreate": {
-			reason: "Should return true if management policies are enabled and managementPolicies has action Create",
+		"IfChangedUpToDate": {
+			reason: "A no-op reconcile of an up-to-date resource should not update its status when the strategy is IfChanged.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionCreate},
+				get:      synced,
+				strategy: StatusUpdateIfChanged,
 			},
-			want: true,
+			want: 0,
 		},
-		"ManagementPoliciesEnabledHasCreateAll": {
-			reason: "Should return true if management policies are enabled and managementPolicies has action All",
+		"IfChangedStatusChanged": {
+			reason: "A reconcile that changes the status of a resource should update its status when the strategy is IfChanged.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+				get:      func(_ client.Object) error { return nil },
+				strategy: StatusUpdateIfChanged,
 			},
-			want: true,
+			want: 1,
 		},
-		"ManagementPoliciesEnabledActionNotAllowed": {
-			reason: "Should return false if management policies are enabled and managementPolicies does not have Create",
+		"AlwaysUpToDate": {
+			reason: "A no-op reconcile of an up-to-date resource should update its status when the strategy is Always.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+				get:      synced,
+				strategy: StatusUpdateAlways,
 			},
-			want: false,
+			want: 1,
 		},
 	}
+
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
-			if diff := cmp.Diff(tc.want, r.ShouldCreate()); diff != "" {
-				t.Errorf("\nReason: %s\nShouldCreate(...): -want, +got:\n%s", tc.reason, diff)
+			updates := 0
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, tc.args.get),
+				MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+					updates++
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithStatusUpdateStrategy(tc.args.strategy),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, updates); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want status updates, +got status updates:\n%s", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestManagementPoliciesResolverShouldUpdate(t *testing.T) {
-	type args struct {
-		managementPoliciesEnabled bool
-		policy                    xpv1.ManagementPolicies
+type correlationIDKey struct{}
+
+func TestReconcilerContextDecorator(t *testing.T) {
+	type want struct {
+		id          any
+		hasDeadline bool
 	}
+
 	cases := map[string]struct {
 		reason string
-		args   args
-		want   bool
+		d      ContextDecorator
+		want   want
 	}{
-		"ManagementPoliciesDisabled": {
-			reason: "Should return true if management policies are disabled",
-			args: args{
-				managementPoliciesEnabled: false,
+		"DecoratedValue": {
+			reason: "Values added by the context decorator should be visible to the external client.",
+			d: func(ctx context.Context, mg resource.Managed) context.Context {
+				return context.WithValue(ctx, correlationIDKey{}, mg.GetName())
 			},
-			want: true,
+			want: want{id: "cool-managed", hasDeadline: true},
 		},
-		"ManagementPoliciesEnabledHasUpdate": {
-			reason: "Should return true if management policies are enabled and managementPolicies has action Update",
-			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionUpdate},
+		"DetachedContext": {
+			reason: "The decorated context should respect the reconcile timeout even if the decorator does not derive it from the supplied context.",
+			d: func(_ context.Context, mg resource.Managed) context.Context {
+				return context.WithValue(context.Background(), correlationIDKey{}, mg.GetName())
 			},
-			want: true,
+			want: want{id: "cool-managed", hasDeadline: true},
 		},
-		"ManagementPoliciesEnabledHasUpdateAll": {
-			reason: "Should return true if management policies are enabled and managementPolicies has action All",
-			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetName("cool-managed")
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithContextDecorator(tc.d),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+							_, hasDeadline := ctx.Deadline()
+							got = want{id: ctx.Value(correlationIDKey{}), hasDeadline: hasDeadline}
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nObserveFn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerProviderConfigUsageTracker(t *testing.T) {
+	var usage *fake.ProviderConfigUsage
+	creates, updates := 0, 0
+
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			switch o := obj.(type) {
+			case *fake.Managed:
+				o.SetUID("cool-uid")
+				o.SetProviderConfigReference(&xpv1.Reference{Name: "cool-config"})
+			case *fake.ProviderConfigUsage:
+				if usage == nil {
+					return kerrors.NewNotFound(schema.GroupResource{}, "")
+				}
+				*o = *usage
+			}
+			return nil
+		}),
+		MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+			creates++
+			// fake.ProviderConfigUsage can't be deep copied without losing
+			// its references, so we take a shallow copy.
+			u := *obj.(*fake.ProviderConfigUsage)
+			usage = &u
+			return nil
+		}),
+		MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+			if _, ok := obj.(*fake.ProviderConfigUsage); ok {
+				updates++
+			}
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+
+	r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithProviderConfigUsageTracker(resource.NewProviderConfigUsageTracker(c, &fake.ProviderConfigUsage{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			if usage == nil {
+				t.Errorf("Usage should be tracked before connecting to the external system")
+			}
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+			t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+		}
+		if diff := cmp.Diff(1, creates); diff != "" {
+			t.Errorf("Reconcile %d: a usage should be created on the first reconcile and not duplicated on subsequent reconciles: -want creates, +got creates:\n%s", i, diff)
+		}
+		if diff := cmp.Diff(0, updates); diff != "" {
+			t.Errorf("Reconcile %d: an up-to-date usage should not be updated: -want updates, +got updates:\n%s", i, diff)
+		}
+	}
+	if diff := cmp.Diff(xpv1.Reference{Name: "cool-config"}, usage.GetProviderConfigReference()); diff != "" {
+		t.Errorf("The usage should reference the managed resource's provider config: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerProviderConfigUsageTrackerError(t *testing.T) {
+	errBoom := errors.New("boom")
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil),
+		MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			want := &fake.Managed{}
+			want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errTrackUsage)))
+			if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+				t.Errorf("Errors tracking usage should be reported as a conditioned status: -want, +got:\n%s", diff)
+			}
+			return nil
+		}),
+	}
+	r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithProviderConfigUsageTracker(resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return errBoom })),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			t.Errorf("We should not connect to the external system if we cannot track usage")
+			return nil, nil
+		})),
+	)
+	got, err := r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(reconcile.Result{Requeue: true}, got); diff != "" {
+		t.Errorf("r.Reconcile(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerStaleConditionsHook(t *testing.T) {
+	errBoom := errors.New("boom")
+	lastBackup := xpv1.Condition{Type: "LastBackup", Status: corev1.ConditionTrue, Reason: "BackedUp"}
+
+	type want struct {
+		called     bool
+		conditions []xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason  string
+		observe func(context.Context, resource.Managed) (ExternalObservation, error)
+		want    want
+	}{
+		"Success": {
+			reason: "The hook should be called on a successful reconcile, and conditions it removes should not be persisted.",
+			observe: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+				return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
 			},
-			want: true,
+			want: want{called: true, conditions: []xpv1.Condition{xpv1.ReconcileSuccess()}},
 		},
-		"ManagementPoliciesEnabledActionNotAllowed": {
-			reason: "Should return false if management policies are enabled and managementPolicies does not have Update",
-			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+		"Error": {
+			reason: "The hook should not be called on an unsuccessful reconcile.",
+			observe: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+				return ExternalObservation{}, errBoom
 			},
-			want: false,
+			want: want{conditions: []xpv1.Condition{lastBackup, xpv1.ReconcileError(errors.Wrap(errBoom, errReconcileObserve))}},
 		},
 	}
+
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
-			if diff := cmp.Diff(tc.want, r.ShouldUpdate()); diff != "" {
-				t.Errorf("\nReason: %s\nShouldUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			called := false
+			var got []xpv1.Condition
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*fake.Managed).SetConditions(lastBackup)
+					return nil
+				}),
+				MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					got = obj.(*fake.Managed).Conditions
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithStaleConditionsHook(func(_ context.Context, mg resource.Managed) {
+					called = true
+					mg.(*fake.Managed).RemoveConditions("LastBackup")
+				}),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{ObserveFn: tc.observe, DisconnectFn: func(_ context.Context) error { return nil }}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			_, _ = r.Reconcile(context.Background(), reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\nReason: %s\nStaleConditionsHook called: -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, got, test.EquateConditions(), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\nReason: %s\nStatus().Update(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestManagementPoliciesResolverShouldLateInitialize(t *testing.T) {
+type reasonRecorder struct {
+	reasons []event.Reason
+}
+
+func (r *reasonRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func (r *reasonRecorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestReconcilerDeletionGracePeriod(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+
 	type args struct {
-		managementPoliciesEnabled bool
-		policy                    xpv1.ManagementPolicies
+		attempted time.Time
+		grace     time.Duration
+		err       error
+	}
+
+	type want struct {
+		result           reconcile.Result
+		recordedAttempt  bool
+		finalizerRemoved bool
+		reasons          []event.Reason
 	}
+
 	cases := map[string]struct {
 		reason string
 		args   args
-		want   bool
+		want   want
 	}{
-		"ManagementPoliciesDisabled": {
-			reason: "Should return true if management policies are disabled",
+		"NoGracePeriod": {
+			reason: "By default we should retry deletion forever, without recording when deletion was first attempted.",
 			args: args{
-				managementPoliciesEnabled: false,
+				attempted: time.Now().Add(-24 * time.Hour),
+			},
+			want: want{
+				result:  reconcile.Result{Requeue: true},
+				reasons: []event.Reason{reasonCannotDelete},
 			},
-			want: true,
 		},
-		"ManagementPoliciesEnabledHasLateInitialize": {
-			reason: "Should return true if management policies are enabled and managementPolicies has action LateInitialize",
+		"TerminalError": {
+			reason: "We should retry deletion at our poll interval when it fails with a terminal error, because nothing else will bring us back.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionLateInitialize},
+				err: resource.Terminal(errBoom),
+			},
+			want: want{
+				result:  reconcile.Result{RequeueAfter: defaultPollInterval},
+				reasons: []event.Reason{reasonCannotDelete},
 			},
-			want: true,
 		},
-		"ManagementPoliciesEnabledHasLateInitializeAll": {
-			reason: "Should return true if management policies are enabled and managementPolicies has action All",
+		"FirstFailure": {
+			reason: "We should record when deletion first failed, and retry.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+				grace: time.Hour,
+			},
+			want: want{
+				result:          reconcile.Result{Requeue: true},
+				recordedAttempt: true,
+				reasons:         []event.Reason{reasonCannotDelete},
 			},
-			want: true,
 		},
-		"ManagementPoliciesEnabledActionNotAllowed": {
-			reason: "Should return false if management policies are enabled and managementPolicies does not have LateInitialize",
+		"WithinGracePeriod": {
+			reason: "We should retry deletion within the grace period.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+				attempted: time.Now().Add(-1 * time.Minute),
+				grace:     time.Hour,
+			},
+			want: want{
+				result:  reconcile.Result{Requeue: true},
+				reasons: []event.Reason{reasonCannotDelete},
+			},
+		},
+		"GracePeriodExpired": {
+			reason: "We should remove our finalizer and warn that the external resource was orphaned once the grace period expires.",
+			args: args{
+				attempted: time.Now().Add(-2 * time.Hour),
+				grace:     time.Hour,
+			},
+			want: want{
+				result:           reconcile.Result{Requeue: false},
+				finalizerRemoved: true,
+				reasons:          []event.Reason{reasonOrphaned},
 			},
-			want: false,
 		},
 	}
+
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
-			if diff := cmp.Diff(tc.want, r.ShouldLateInitialize()); diff != "" {
-				t.Errorf("\nReason: %s\nShouldLateInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			got := want{}
+			rec := &reasonRecorder{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					mg := obj.(*fake.Managed)
+					mg.SetDeletionTimestamp(&now)
+					mg.SetDeletionPolicy(xpv1.DeletionDelete)
+					if !tc.args.attempted.IsZero() {
+						meta.SetDeletionAttemptTime(mg, tc.args.attempted)
+					}
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithDeletionGracePeriod(tc.args.grace),
+				WithRecorder(rec),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							if tc.args.err != nil {
+								return ExternalDelete{}, tc.args.err
+							}
+							return ExternalDelete{}, errBoom
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, o client.Object) error {
+					got.recordedAttempt = !meta.GetDeletionAttemptTime(o).IsZero()
+					return nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error {
+					got.finalizerRemoved = true
+					return nil
+				}}),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.result = result
+			got.reasons = rec.reasons
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestManagementPoliciesResolverOnlyObserve(t *testing.T) {
-	type args struct {
-		managementPoliciesEnabled bool
-		policy                    xpv1.ManagementPolicies
+func TestReconcilerClockCreateTimestamps(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clk := testingclock.NewFakeClock(now)
+
+	type want struct {
+		pending   time.Time
+		succeeded time.Time
 	}
-	cases := map[string]struct {
-		reason string
+	got := want{}
+
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil),
+		MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+			got.pending = meta.GetExternalCreatePending(obj)
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithClock(clk),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: false}, nil
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+					// Creating the external resource takes a minute.
+					clk.Step(time.Minute)
+					return ExternalCreation{}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, o client.Object) error {
+			got.succeeded = meta.GetExternalCreateSucceeded(o)
+			return nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+
+	w := want{pending: now, succeeded: now.Add(time.Minute)}
+	if diff := cmp.Diff(w, got, cmp.AllowUnexported(want{})); diff != "" {
+		t.Errorf("r.Reconcile(...): -want create annotations, +got create annotations:\n%s", diff)
+	}
+}
+
+func TestReconcilerClockGracePeriods(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	grace := time.Hour
+
+	type args struct {
+		deleted bool
+		elapsed time.Duration
+	}
+
+	type want struct {
+		result           reconcile.Result
+		created          bool
+		finalizerRemoved bool
+	}
+
+	cases := map[string]struct {
+		reason string
 		args   args
-		want   bool
+		want   want
 	}{
-		"ManagementPoliciesDisabled": {
-			reason: "Should return false if management policies are disabled",
+		"CreationGracePeriodActive": {
+			reason: "We should wait for the external resource to exist until the creation grace period expires.",
 			args: args{
-				managementPoliciesEnabled: false,
+				elapsed: grace - time.Second,
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
 			},
-			want: false,
 		},
-		"ManagementPoliciesEnabledHasOnlyObserve": {
-			reason: "Should return true if management policies are enabled and managementPolicies has action LateInitialize",
+		"CreationGracePeriodExpired": {
+			reason: "We should create the external resource again once the creation grace period expires.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+				elapsed: grace,
+			},
+			want: want{
+				result:  reconcile.Result{Requeue: true},
+				created: true,
 			},
-			want: true,
 		},
-		"ManagementPoliciesEnabledHasMultipleActions": {
-			reason: "Should return false if management policies are enabled and managementPolicies has multiple actions",
+		"DeletionGracePeriodActive": {
+			reason: "We should retry deletion until the deletion grace period expires.",
 			args: args{
-				managementPoliciesEnabled: true,
-				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionLateInitialize, xpv1.ManagementActionObserve},
+				deleted: true,
+				elapsed: grace - time.Second,
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+			},
+		},
+		"DeletionGracePeriodExpired": {
+			reason: "We should orphan the external resource once the deletion grace period expires.",
+			args: args{
+				deleted: true,
+				elapsed: grace,
+			},
+			want: want{
+				result:           reconcile.Result{Requeue: false},
+				finalizerRemoved: true,
 			},
-			want: false,
 		},
 	}
+
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
-			if diff := cmp.Diff(tc.want, r.ShouldOnlyObserve()); diff != "" {
-				t.Errorf("\nReason: %s\nShouldOnlyObserve(...): -want, +got:\n%s", tc.reason, diff)
+			got := want{}
+			clk := testingclock.NewFakeClock(now)
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					mg := obj.(*fake.Managed)
+					if tc.args.deleted {
+						mg.SetDeletionTimestamp(&metav1.Time{Time: now})
+						mg.SetDeletionPolicy(xpv1.DeletionDelete)
+						meta.SetDeletionAttemptTime(mg, now)
+						return nil
+					}
+					meta.SetExternalCreateSucceeded(mg, now)
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			clk.Step(tc.args.elapsed)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithClock(clk),
+				WithCreationGracePeriod(grace),
+				WithDeletionGracePeriod(grace),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: tc.args.deleted}, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							got.created = true
+							return ExternalCreation{}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							return ExternalDelete{}, errBoom
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{
+					AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil },
+					RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error {
+						got.finalizerRemoved = true
+						return nil
+					},
+				}),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.result = result
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestShouldDelete(t *testing.T) {
-	type args struct {
-		managementPoliciesEnabled bool
-		managed                   resource.Managed
+func TestReconcilerDeletionGracePeriodTerminalError(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	grace := 30 * time.Second
+	clk := testingclock.NewFakeClock(now)
+
+	// The managed resource as persisted between reconciles.
+	stored := &fake.Managed{}
+	stored.SetDeletionTimestamp(&metav1.Time{Time: now})
+	stored.SetDeletionPolicy(xpv1.DeletionDelete)
+
+	orphaned := false
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*fake.Managed) = *stored.DeepCopyObject().(*fake.Managed)
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
 	}
-	type want struct {
-		delete bool
+	r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithClock(clk),
+		WithDeletionGracePeriod(grace),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: true}, nil
+				},
+				DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+					return ExternalDelete{}, resource.Terminal(errBoom)
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, o client.Object) error {
+			stored.SetAnnotations(o.GetAnnotations())
+			return nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error {
+			orphaned = true
+			return nil
+		}}),
+	)
+
+	result, err := r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: grace}, result); diff != "" {
+		t.Errorf("r.Reconcile(...): we should requeue when the deletion grace period expires: -want, +got:\n%s", diff)
+	}
+	if orphaned {
+		t.Errorf("r.Reconcile(...): we should not orphan the external resource before the deletion grace period expires")
+	}
+
+	clk.Step(result.RequeueAfter)
+
+	result, err = r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(reconcile.Result{Requeue: false}, result); diff != "" {
+		t.Errorf("r.Reconcile(...): -want, +got:\n%s", diff)
+	}
+	if !orphaned {
+		t.Errorf("r.Reconcile(...): we should orphan the external resource once the deletion grace period expires")
+	}
+}
+
+func TestReconcilerDeletedTerminalError(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	grace := 30 * time.Second
+
+	type args struct {
+		connectErr error
+		observeErr error
+		attempted  time.Time
 	}
+
 	cases := map[string]struct {
 		reason string
 		args   args
-		want   want
+		want   reconcile.Result
 	}{
-		"DeletionOrphan": {
-			reason: "Should orphan if management policies are disabled and deletion policy is set to Orphan.",
+		"ConnectError": {
+			reason: "A terminal error connecting to the provider of a deleted managed resource should requeue it at the poll interval.",
 			args: args{
-				managementPoliciesEnabled: false,
-				managed: &fake.Managed{
-					Orphanable: fake.Orphanable{
-						Policy: xpv1.DeletionOrphan,
-					},
-				},
+				connectErr: resource.Terminal(errBoom),
 			},
-			want: want{delete: false},
+			want: reconcile.Result{RequeueAfter: defaultPollInterval},
 		},
-		"DeletionDelete": {
-			reason: "Should delete if management policies are disabled and deletion policy is set to Delete.",
+		"ObserveError": {
+			reason: "A terminal error observing the external resource of a deleted managed resource should requeue it at the poll interval.",
 			args: args{
-				managementPoliciesEnabled: false,
-				managed: &fake.Managed{
-					Orphanable: fake.Orphanable{
-						Policy: xpv1.DeletionDelete,
-					},
-				},
+				observeErr: resource.Terminal(errBoom),
 			},
-			want: want{delete: true},
+			want: reconcile.Result{RequeueAfter: defaultPollInterval},
 		},
-		"DeletionDeleteManagementActionAll": {
-			reason: "Should delete if management policies are enabled and deletion policy is set to Delete and management policy is set to All.",
+		"ObserveErrorDeletionGracePeriod": {
+			reason: "A terminal error observing the external resource of a deleted managed resource should requeue it when its deletion grace period expires, if that's sooner than the poll interval.",
 			args: args{
-				managementPoliciesEnabled: true,
-				managed: &fake.Managed{
-					Orphanable: fake.Orphanable{
-						Policy: xpv1.DeletionDelete,
-					},
-					Manageable: fake.Manageable{
-						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionAll},
-					},
-				},
+				observeErr: resource.Terminal(errBoom),
+				attempted:  now.Add(-grace + 10*time.Second),
 			},
-			want: want{delete: true},
+			want: reconcile.Result{RequeueAfter: 10 * time.Second},
 		},
-		"DeletionOrphanManagementActionAll": {
-			reason: "Should orphan if management policies are enabled and deletion policy is set to Orphan and management policy is set to All.",
-			args: args{
-				managementPoliciesEnabled: true,
-				managed: &fake.Managed{
-					Orphanable: fake.Orphanable{
-						Policy: xpv1.DeletionOrphan,
-					},
-					Manageable: fake.Manageable{
-						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionAll},
-					},
-				},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetDeletionTimestamp(&metav1.Time{Time: now})
+			mg.SetDeletionPolicy(xpv1.DeletionDelete)
+			if !tc.args.attempted.IsZero() {
+				meta.SetDeletionAttemptTime(mg, tc.args.attempted)
+			}
+
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *mg.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithClock(testingclock.NewFakeClock(now)),
+				WithDeletionGracePeriod(grace),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					if tc.args.connectErr != nil {
+						return nil, tc.args.connectErr
+					}
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{}, tc.args.observeErr
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{}),
+			)
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerObserveOnOrphanDelete(t *testing.T) {
+	now := metav1.Now()
+
+	type want struct {
+		observed         bool
+		deleted          bool
+		unpublished      ConnectionDetails
+		finalizerRemoved bool
+	}
+
+	cases := map[string]struct {
+		reason  string
+		observe bool
+		want    want
+	}{
+		"Disabled": {
+			reason: "By default we should not observe the external resource when orphaning it.",
+			want: want{
+				unpublished:      ConnectionDetails{},
+				finalizerRemoved: true,
 			},
-			want: want{delete: false},
 		},
-		"DeletionDeleteManagementActionDelete": {
-			reason: "Should delete if management policies are enabled and deletion policy is set to Delete and management policy has action Delete.",
-			args: args{
-				managementPoliciesEnabled: true,
-				managed: &fake.Managed{
-					Orphanable: fake.Orphanable{
-						Policy: xpv1.DeletionDelete,
-					},
-					Manageable: fake.Manageable{
-						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionDelete},
-					},
-				},
+		"Enabled": {
+			reason:  "We should observe, but not delete, the external resource when orphaning it if asked to.",
+			observe: true,
+			want: want{
+				observed:         true,
+				unpublished:      ConnectionDetails{"endpoint": []byte("example.org")},
+				finalizerRemoved: true,
 			},
-			want: want{delete: true},
 		},
-		"DeletionOrphanManagementActionDelete": {
-			reason: "Should delete if management policies are enabled and deletion policy is set to Orphan and management policy has action Delete.",
-			args: args{
-				managementPoliciesEnabled: true,
-				managed: &fake.Managed{
-					Orphanable: fake.Orphanable{
-						Policy: xpv1.DeletionOrphan,
-					},
-					Manageable: fake.Manageable{
-						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionDelete},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					mg := obj.(*fake.Managed)
+					mg.SetDeletionTimestamp(&now)
+					mg.SetDeletionPolicy(xpv1.DeletionOrphan)
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithObserveOnOrphanDelete(tc.observe),
+				WithInitializers(),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							got.observed = true
+							return ExternalObservation{
+								ResourceExists:    true,
+								ConnectionDetails: ConnectionDetails{"endpoint": []byte("example.org")},
+							}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							got.deleted = true
+							return ExternalDelete{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(ConnectionPublisherFns{
+					UnpublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, c ConnectionDetails) error {
+						got.unpublished = c
+						return nil
 					},
-				},
+				}),
+				WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error {
+					got.finalizerRemoved = true
+					return nil
+				}}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerManagedMutator(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		result   reconcile.Result
+		observed map[string]string
+		cond     xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason  string
+		mutator ManagedMutator
+		want    want
+	}{
+		"Mutated": {
+			reason: "Changes made by the mutator should be visible to Observe.",
+			mutator: func(_ context.Context, mg resource.Managed) error {
+				mg.SetLabels(map[string]string{"region": "us-east-1"})
+				return nil
+			},
+			want: want{
+				result:   reconcile.Result{RequeueAfter: defaultPollInterval},
+				observed: map[string]string{"region": "us-east-1"},
+				cond:     xpv1.ReconcileSuccess(),
 			},
-			want: want{delete: true},
 		},
-		"DeletionDeleteManagementActionNoDelete": {
-			reason: "Should orphan if management policies are enabled and deletion policy is set to Delete and management policy does not have action Delete.",
-			args: args{
-				managementPoliciesEnabled: true,
-				managed: &fake.Managed{
-					Orphanable: fake.Orphanable{
-						Policy: xpv1.DeletionDelete,
-					},
-					Manageable: fake.Manageable{
-						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
-					},
-				},
+		"MutatorError": {
+			reason: "We should requeue with a ReconcileError condition, without observing, if the mutator fails.",
+			mutator: func(_ context.Context, _ resource.Managed) error {
+				return errBoom
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				cond:   xpv1.ReconcileError(errors.Wrap(errBoom, errMutateManaged)),
 			},
-			want: want{delete: false},
 		},
 	}
+
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.managed.GetManagementPolicies(), tc.args.managed.GetDeletionPolicy())
-			if diff := cmp.Diff(tc.want.delete, r.ShouldDelete()); diff != "" {
-				t.Errorf("\nReason: %s\nShouldDelete(...): -want, +got:\n%s", tc.reason, diff)
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+				MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					got.cond = obj.(*fake.Managed).GetCondition(xpv1.TypeSynced)
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithManagedMutator(tc.mutator),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+							got.observed = mg.GetLabels()
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.result = result
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
 			}
 		})
 	}
 }
 
-func TestReconcilerChangeLogs(t *testing.T) {
-	type args struct {
-		m  manager.Manager
-		mg resource.ManagedKind
-		o  []ReconcilerOption
-		c  *changeLogServiceClient
-	}
+func TestReconcilerReferenceResolutionTimeout(t *testing.T) {
+	timeout := 10 * time.Millisecond
 
 	type want struct {
-		callCount  int
-		opType     v1alpha1.OperationType
-		errMessage string
+		result   reconcile.Result
+		observed bool
+		cond     xpv1.Condition
 	}
 
-	now := metav1.Now()
-	errBoom := errors.New("boom")
-
 	cases := map[string]struct {
-		reason string
-		args   args
-		want   want
+		reason   string
+		resolver ReferenceResolverFn
+		want     want
 	}{
-		"CreateSuccessfulWithChangeLogs": {
-			reason: "Successful managed resource creation should send a create change log entry when change logs are enabled.",
-			args: args{
-				m: &fake.Manager{
-					Client: &test.MockClient{
-						MockGet:          test.NewMockGetFn(nil),
-						MockUpdate:       test.NewMockUpdateFn(nil),
-						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
-					},
-					Scheme: fake.SchemeWith(&fake.Managed{}),
-				},
-				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
-				o: []ReconcilerOption{
-					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
-						c := &ExternalClientFns{
-							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
-								// resource doesn't exist, which should trigger a create operation
-								return ExternalObservation{ResourceExists: false, ResourceUpToDate: false}, nil
-							},
-							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
-								return ExternalCreation{}, nil
-							},
-							DisconnectFn: func(_ context.Context) error {
-								return nil
-							},
-						}
-						return c, nil
-					})),
+		"ResolvedWithinTimeout": {
+			reason: "References resolved within the timeout should not prevent us from observing the external resource.",
+			resolver: func(_ context.Context, _ resource.Managed) error {
+				return nil
+			},
+			want: want{
+				result:   reconcile.Result{RequeueAfter: defaultPollInterval},
+				observed: true,
+				cond:     xpv1.ReconcileSuccess(),
+			},
+		},
+		"TimedOut": {
+			reason: "We should requeue with a ReconcileError condition, without observing, if reference resolution exceeds its timeout.",
+			resolver: func(ctx context.Context, _ resource.Managed) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				cond:   xpv1.ReconcileError(errors.Wrapf(context.DeadlineExceeded, errFmtResolveRefsTimeout, timeout)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+				MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					got.cond = obj.(*fake.Managed).GetCondition(xpv1.TypeSynced)
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithReferenceResolutionTimeout(timeout),
+				WithInitializers(),
+				WithReferenceResolver(tc.resolver),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							got.observed = true
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.result = result
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerConditionTransformer(t *testing.T) {
+	errBoom := errors.New("boom")
+	custom := xpv1.ConditionType("Custom")
+
+	var got []xpv1.Condition
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil),
+		MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			got = obj.(*fake.Managed).Conditions
+			return nil
+		}),
+	}
+	r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithConditionTransformer(func(_ resource.Managed, cs []xpv1.Condition) []xpv1.Condition {
+			out := make([]xpv1.Condition, 0, len(cs)+1)
+			for _, c := range cs {
+				if c.Type == xpv1.TypeSynced && c.Reason == xpv1.ReasonReconcileError {
+					out = append(out, c.WithMessage("cannot observe external resource - contact your administrator"), xpv1.Condition{
+						Type:   custom,
+						Status: corev1.ConditionFalse,
+						Reason: "ObserveFailed",
+					})
+				}
+			}
+			return out
+		}),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{}, errBoom
 				},
-				c: &changeLogServiceClient{},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+
+	want := []xpv1.Condition{
+		xpv1.ReconcileError(errBoom).WithMessage("cannot observe external resource - contact your administrator"),
+		{Type: custom, Status: corev1.ConditionFalse, Reason: "ObserveFailed"},
+	}
+	if diff := cmp.Diff(want, got, test.EquateConditions()); diff != "" {
+		t.Errorf("r.Reconcile(...): -want conditions, +got conditions:\n%s", diff)
+	}
+}
+
+func TestReconcilerConditionTransformerIfChanged(t *testing.T) {
+	errBoom := errors.New("boom")
+	then := metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	masked := "cannot observe external resource - contact your administrator"
+
+	// The managed resource as persisted between reconciles, with a condition
+	// the transformer previously rewrote.
+	stored := &fake.Managed{}
+	stored.SetConditions(xpv1.ReconcileError(errBoom).WithMessage(masked))
+	stored.Conditions[0].LastTransitionTime = then
+
+	updates := 0
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			*obj.(*fake.Managed) = *stored.DeepCopyObject().(*fake.Managed)
+			return nil
+		}),
+		MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			updates++
+			*stored = *obj.DeepCopyObject().(*fake.Managed)
+			return nil
+		}),
+	}
+	r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithStatusUpdateStrategy(StatusUpdateIfChanged),
+		WithConditionTransformer(func(_ resource.Managed, cs []xpv1.Condition) []xpv1.Condition {
+			out := make([]xpv1.Condition, 0, len(cs))
+			for _, c := range cs {
+				if c.Type == xpv1.TypeSynced && c.Reason == xpv1.ReasonReconcileError {
+					out = append(out, c.WithMessage(masked))
+				}
+			}
+			return out
+		}),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{}, errBoom
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+			t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+		}
+	}
+
+	if diff := cmp.Diff(0, updates); diff != "" {
+		t.Errorf("r.Reconcile(...): reconciles that don't change the transformed conditions shouldn't update status: -want status updates, +got status updates:\n%s", diff)
+	}
+	if got := stored.GetCondition(xpv1.TypeSynced).LastTransitionTime; !got.Equal(&then) {
+		t.Errorf("r.Reconcile(...): want last transition time %s, got %s", then, got)
+	}
+}
+
+func TestReconcilerManagedUpdater(t *testing.T) {
+	type want struct {
+		original map[string]string
+		updated  map[string]string
+	}
+
+	got := want{}
+	c := &test.MockClient{
+		MockGet:          test.NewMockGetFn(nil),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+		WithManagedUpdater(ManagedUpdaterFn(func(_ context.Context, original, mg resource.Managed) error {
+			got.original = original.GetLabels()
+			got.updated = mg.GetLabels()
+			return nil
+		})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+					mg.SetLabels(map[string]string{"late": "initialized"})
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithConnectionPublishers(),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %s", err)
+	}
+
+	// The ManagedUpdater should be passed the managed resource as it was
+	// before it was late initialized, and as it was after.
+	w := want{updated: map[string]string{"late": "initialized"}}
+	if diff := cmp.Diff(w, got, cmp.AllowUnexported(want{})); diff != "" {
+		t.Errorf("r.Reconcile(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcilerManagedObjectFactory(t *testing.T) {
+	type want struct {
+		panicked bool
+		labels   map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      []ReconcilerOption
+		want   want
+	}{
+		"DefaultFactory": {
+			reason: "By default NewReconciler should panic if the reconciled kind isn't registered with the scheme.",
+			want: want{
+				panicked: true,
+			},
+		},
+		"CustomFactory": {
+			reason: "A custom factory should be used instead of looking up the reconciled kind in the scheme.",
+			o: []ReconcilerOption{
+				WithManagedObjectFactory(func() resource.Managed {
+					return &fake.Managed{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"constructed-by": "factory"}}}
+				}),
 			},
 			want: want{
-				callCount:  1,
-				opType:     v1alpha1.OperationType_OPERATION_TYPE_CREATE,
-				errMessage: "",
+				labels: map[string]string{"constructed-by": "factory"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+							got.labels = mg.GetLabels()
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.o...)
+
+			func() {
+				defer func() {
+					if recover() != nil {
+						got.panicked = true
+					}
+				}()
+				// Note that fake.Managed isn't registered with this scheme.
+				r := NewReconciler(&fake.Manager{Client: c, Scheme: runtime.NewScheme()}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+				if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+					t.Errorf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+				}
+			}()
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nNewReconciler(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTestManagementPoliciesResolverIsPaused(t *testing.T) {
+	type args struct {
+		enabled bool
+		policy  xpv1.ManagementPolicies
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"Disabled": {
+			reason: "Should return false if management policies are disabled",
+			args: args{
+				enabled: false,
+				policy:  xpv1.ManagementPolicies{},
+			},
+			want: false,
+		},
+		"EnabledEmptyPolicies": {
+			reason: "Should return true if the management policies are enabled and empty",
+			args: args{
+				enabled: true,
+				policy:  xpv1.ManagementPolicies{},
+			},
+			want: true,
+		},
+		"EnabledNonEmptyPolicies": {
+			reason: "Should return true if the management policies are enabled and non empty",
+			args: args{
+				enabled: true,
+				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewManagementPoliciesResolver(tc.args.enabled, tc.args.policy, xpv1.DeletionDelete)
+			if diff := cmp.Diff(tc.want, r.IsPaused()); diff != "" {
+				t.Errorf("\nReason: %s\nIsPaused(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPoliciesResolverValidate(t *testing.T) {
+	type args struct {
+		enabled bool
+		policy  xpv1.ManagementPolicies
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"Enabled": {
+			reason: "Should return nil if the management policy is enabled.",
+			args: args{
+				enabled: true,
+				policy:  xpv1.ManagementPolicies{},
+			},
+			want: nil,
+		},
+		"DisabledNonDefault": {
+			reason: "Should return error if the management policy is non-default and disabled.",
+			args: args{
+				enabled: false,
+				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionCreate},
+			},
+			want: fmt.Errorf(errFmtManagementPolicyNonDefault, []xpv1.ManagementAction{xpv1.ManagementActionCreate}),
+		},
+		"DisabledDefault": {
+			reason: "Should return nil if the management policy is default and disabled.",
+			args: args{
+				enabled: false,
+				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+			},
+			want: nil,
+		},
+		"EnabledSupported": {
+			reason: "Should return nil if the management policy is supported.",
+			args: args{
+				enabled: true,
+				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+			},
+			want: nil,
+		},
+		"EnabledNotSupported": {
+			reason: "Should return err if the management policy is not supported.",
+			args: args{
+				enabled: true,
+				policy:  xpv1.ManagementPolicies{xpv1.ManagementActionDelete},
+			},
+			want: fmt.Errorf(errFmtManagementPolicyNotSupported, []xpv1.ManagementAction{xpv1.ManagementActionDelete}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewManagementPoliciesResolver(tc.args.enabled, tc.args.policy, xpv1.DeletionDelete)
+			if diff := cmp.Diff(tc.want, r.Validate(), test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nIsNonDefault(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPoliciesResolverShouldCreate(t *testing.T) {
+	type args struct {
+		managementPoliciesEnabled bool
+		policy                    xpv1.ManagementPolicies
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"ManagementPoliciesDisabled": {
+			reason: "Should return true if management policies are disabled",
+			args: args{
+				managementPoliciesEnabled: false,
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledHasCreate": {
+			reason: "Should return true if management policies are enabled and managementPolicies has action Create",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionCreate},
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledHasCreateAll": {
+			reason: "Should return true if management policies are enabled and managementPolicies has action All",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledActionNotAllowed": {
+			reason: "Should return false if management policies are enabled and managementPolicies does not have Create",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
+			if diff := cmp.Diff(tc.want, r.ShouldCreate()); diff != "" {
+				t.Errorf("\nReason: %s\nShouldCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPoliciesResolverShouldUpdate(t *testing.T) {
+	type args struct {
+		managementPoliciesEnabled bool
+		policy                    xpv1.ManagementPolicies
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"ManagementPoliciesDisabled": {
+			reason: "Should return true if management policies are disabled",
+			args: args{
+				managementPoliciesEnabled: false,
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledHasUpdate": {
+			reason: "Should return true if management policies are enabled and managementPolicies has action Update",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionUpdate},
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledHasUpdateAll": {
+			reason: "Should return true if management policies are enabled and managementPolicies has action All",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledActionNotAllowed": {
+			reason: "Should return false if management policies are enabled and managementPolicies does not have Update",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
+			if diff := cmp.Diff(tc.want, r.ShouldUpdate()); diff != "" {
+				t.Errorf("\nReason: %s\nShouldUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPoliciesResolverShouldLateInitialize(t *testing.T) {
+	type args struct {
+		managementPoliciesEnabled bool
+		policy                    xpv1.ManagementPolicies
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"ManagementPoliciesDisabled": {
+			reason: "Should return true if management policies are disabled",
+			args: args{
+				managementPoliciesEnabled: false,
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledHasLateInitialize": {
+			reason: "Should return true if management policies are enabled and managementPolicies has action LateInitialize",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionLateInitialize},
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledHasLateInitializeAll": {
+			reason: "Should return true if management policies are enabled and managementPolicies has action All",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledActionNotAllowed": {
+			reason: "Should return false if management policies are enabled and managementPolicies does not have LateInitialize",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
+			if diff := cmp.Diff(tc.want, r.ShouldLateInitialize()); diff != "" {
+				t.Errorf("\nReason: %s\nShouldLateInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPoliciesResolverOnlyObserve(t *testing.T) {
+	type args struct {
+		managementPoliciesEnabled bool
+		policy                    xpv1.ManagementPolicies
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"ManagementPoliciesDisabled": {
+			reason: "Should return false if management policies are disabled",
+			args: args{
+				managementPoliciesEnabled: false,
+			},
+			want: false,
+		},
+		"ManagementPoliciesEnabledHasOnlyObserve": {
+			reason: "Should return true if management policies are enabled and managementPolicies has action LateInitialize",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+			},
+			want: true,
+		},
+		"ManagementPoliciesEnabledHasMultipleActions": {
+			reason: "Should return false if management policies are enabled and managementPolicies has multiple actions",
+			args: args{
+				managementPoliciesEnabled: true,
+				policy:                    xpv1.ManagementPolicies{xpv1.ManagementActionLateInitialize, xpv1.ManagementActionObserve},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.policy, xpv1.DeletionOrphan)
+			if diff := cmp.Diff(tc.want, r.ShouldOnlyObserve()); diff != "" {
+				t.Errorf("\nReason: %s\nShouldOnlyObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestShouldDelete(t *testing.T) {
+	type args struct {
+		managementPoliciesEnabled bool
+		managed                   resource.Managed
+	}
+	type want struct {
+		delete bool
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"DeletionOrphan": {
+			reason: "Should orphan if management policies are disabled and deletion policy is set to Orphan.",
+			args: args{
+				managementPoliciesEnabled: false,
+				managed: &fake.Managed{
+					Orphanable: fake.Orphanable{
+						Policy: xpv1.DeletionOrphan,
+					},
+				},
+			},
+			want: want{delete: false},
+		},
+		"DeletionDelete": {
+			reason: "Should delete if management policies are disabled and deletion policy is set to Delete.",
+			args: args{
+				managementPoliciesEnabled: false,
+				managed: &fake.Managed{
+					Orphanable: fake.Orphanable{
+						Policy: xpv1.DeletionDelete,
+					},
+				},
+			},
+			want: want{delete: true},
+		},
+		"DeletionDeleteManagementActionAll": {
+			reason: "Should delete if management policies are enabled and deletion policy is set to Delete and management policy is set to All.",
+			args: args{
+				managementPoliciesEnabled: true,
+				managed: &fake.Managed{
+					Orphanable: fake.Orphanable{
+						Policy: xpv1.DeletionDelete,
+					},
+					Manageable: fake.Manageable{
+						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+					},
+				},
+			},
+			want: want{delete: true},
+		},
+		"DeletionOrphanManagementActionAll": {
+			reason: "Should orphan if management policies are enabled and deletion policy is set to Orphan and management policy is set to All.",
+			args: args{
+				managementPoliciesEnabled: true,
+				managed: &fake.Managed{
+					Orphanable: fake.Orphanable{
+						Policy: xpv1.DeletionOrphan,
+					},
+					Manageable: fake.Manageable{
+						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionAll},
+					},
+				},
+			},
+			want: want{delete: false},
+		},
+		"DeletionDeleteManagementActionDelete": {
+			reason: "Should delete if management policies are enabled and deletion policy is set to Delete and management policy has action Delete.",
+			args: args{
+				managementPoliciesEnabled: true,
+				managed: &fake.Managed{
+					Orphanable: fake.Orphanable{
+						Policy: xpv1.DeletionDelete,
+					},
+					Manageable: fake.Manageable{
+						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionDelete},
+					},
+				},
+			},
+			want: want{delete: true},
+		},
+		"DeletionOrphanManagementActionDelete": {
+			reason: "Should delete if management policies are enabled and deletion policy is set to Orphan and management policy has action Delete.",
+			args: args{
+				managementPoliciesEnabled: true,
+				managed: &fake.Managed{
+					Orphanable: fake.Orphanable{
+						Policy: xpv1.DeletionOrphan,
+					},
+					Manageable: fake.Manageable{
+						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionDelete},
+					},
+				},
+			},
+			want: want{delete: true},
+		},
+		"DeletionDeleteManagementActionNoDelete": {
+			reason: "Should orphan if management policies are enabled and deletion policy is set to Delete and management policy does not have action Delete.",
+			args: args{
+				managementPoliciesEnabled: true,
+				managed: &fake.Managed{
+					Orphanable: fake.Orphanable{
+						Policy: xpv1.DeletionDelete,
+					},
+					Manageable: fake.Manageable{
+						Policy: xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+					},
+				},
+			},
+			want: want{delete: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewManagementPoliciesResolver(tc.args.managementPoliciesEnabled, tc.args.managed.GetManagementPolicies(), tc.args.managed.GetDeletionPolicy())
+			if diff := cmp.Diff(tc.want.delete, r.ShouldDelete()); diff != "" {
+				t.Errorf("\nReason: %s\nShouldDelete(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerChangeLogs(t *testing.T) {
+	type args struct {
+		m  manager.Manager
+		mg resource.ManagedKind
+		o  []ReconcilerOption
+		c  *changeLogServiceClient
+	}
+
+	type want struct {
+		callCount  int
+		opType     v1alpha1.OperationType
+		errMessage string
+	}
+
+	now := metav1.Now()
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"CreateSuccessfulWithChangeLogs": {
+			reason: "Successful managed resource creation should send a create change log entry when change logs are enabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          test.NewMockGetFn(nil),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								// resource doesn't exist, which should trigger a create operation
+								return ExternalObservation{ResourceExists: false, ResourceUpToDate: false}, nil
+							},
+							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+								return ExternalCreation{}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+				c: &changeLogServiceClient{},
+			},
+			want: want{
+				callCount:  1,
+				opType:     v1alpha1.OperationType_OPERATION_TYPE_CREATE,
+				errMessage: "",
+			},
+		},
+		"CreateFailureWithChangeLogs": {
+			reason: "Failed managed resource creation should send a create change log entry with the error when change logs are enabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          test.NewMockGetFn(nil),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								// resource doesn't exist, which should trigger a create operation
+								return ExternalObservation{ResourceExists: false, ResourceUpToDate: false}, nil
+							},
+							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+								// return an error from Create to simulate a failed creation
+								return ExternalCreation{}, errBoom
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+				c: &changeLogServiceClient{},
+			},
+			want: want{
+				callCount:  1,
+				opType:     v1alpha1.OperationType_OPERATION_TYPE_CREATE,
+				errMessage: errBoom.Error(),
+			},
+		},
+		"UpdateSuccessfulWithChangeLogs": {
+			reason: "Successful managed resource update should send an update change log entry when change logs are enabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          test.NewMockGetFn(nil),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								// resource exists but isn't up to date, which should trigger an update operation
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								return ExternalUpdate{}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+				c: &changeLogServiceClient{},
+			},
+			want: want{
+				callCount:  1,
+				opType:     v1alpha1.OperationType_OPERATION_TYPE_UPDATE,
+				errMessage: "",
+			},
+		},
+		"UpdateFailureWithChangeLogs": {
+			reason: "Failed managed resource update should send an update change log entry with the error when change logs are enabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:          test.NewMockGetFn(nil),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								// resource exists but isn't up to date, which should trigger an update operation
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								// return an error from Update to simulate a failed update
+								return ExternalUpdate{}, errBoom
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+				c: &changeLogServiceClient{},
+			},
+			want: want{
+				callCount:  1,
+				opType:     v1alpha1.OperationType_OPERATION_TYPE_UPDATE,
+				errMessage: errBoom.Error(),
+			},
+		},
+		"DeleteSuccessfulWithChangeLogs": {
+			reason: "Successful managed resource delete should send a delete change log entry when change logs are enabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							// set a deletion timestamp, which should trigger a delete operation
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&now)
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+							return nil
+						}),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								// resource exists but we set a deletion timestamp above, which should trigger a delete operation
+								return ExternalObservation{ResourceExists: true}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+								return ExternalDelete{}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+				c: &changeLogServiceClient{},
+			},
+			want: want{
+				callCount:  1,
+				opType:     v1alpha1.OperationType_OPERATION_TYPE_DELETE,
+				errMessage: "",
+			},
+		},
+		"DeleteFailureWithChangeLogs": {
+			reason: "Failed managed resource delete should send a delete change log entry with the error when change logs are enabled.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							// set a deletion timestamp, which should trigger a delete operation
+							mg := obj.(*fake.Managed)
+							mg.SetDeletionTimestamp(&now)
+							mg.SetDeletionPolicy(xpv1.DeletionDelete)
+							return nil
+						}),
+						MockUpdate:       test.NewMockUpdateFn(nil),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
+					},
+					Scheme: fake.SchemeWith(&fake.Managed{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				o: []ReconcilerOption{
+					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								// resource exists but we set a deletion timestamp above, which should trigger a delete operation
+								return ExternalObservation{ResourceExists: true}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+								// return an error from Delete to simulate a failed delete
+								return ExternalDelete{}, errBoom
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+				c: &changeLogServiceClient{},
+			},
+			want: want{
+				callCount:  1,
+				opType:     v1alpha1.OperationType_OPERATION_TYPE_DELETE,
+				errMessage: errBoom.Error(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.args.o = append(tc.args.o, WithChangeLogger(NewGRPCChangeLogger(tc.args.c, WithProviderVersion("provider-cool:v9.99.999"))))
+			r := NewReconciler(tc.args.m, tc.args.mg, tc.args.o...)
+			r.Reconcile(context.Background(), reconcile.Request{})
+
+			if diff := cmp.Diff(tc.want.callCount, len(tc.args.c.requests)); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want callCount, +got callCount:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.opType, tc.args.c.requests[0].GetEntry().GetOperation()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want opType, +got opType:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.errMessage, tc.args.c.requests[0].GetEntry().GetErrorMessage()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want errMessage, +got errMessage:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// An annotationRecorder records the annotations of each event. Recorders
+// returned by WithAnnotations share the recorded annotations of their parent.
+type annotationRecorder struct {
+	annotations map[string]string
+	recorded    *[]map[string]string
+}
+
+func newAnnotationRecorder() *annotationRecorder {
+	return &annotationRecorder{annotations: map[string]string{}, recorded: &[]map[string]string{}}
+}
+
+func (r *annotationRecorder) Event(_ runtime.Object, _ event.Event) {
+	*r.recorded = append(*r.recorded, r.annotations)
+}
+
+func (r *annotationRecorder) WithAnnotations(keysAndValues ...string) event.Recorder {
+	a := make(map[string]string, len(r.annotations))
+	for k, v := range r.annotations {
+		a[k] = v
+	}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		a[keysAndValues[i]] = keysAndValues[i+1]
+	}
+	return &annotationRecorder{annotations: a, recorded: r.recorded}
+}
+
+type detailsChangeLogger struct {
+	details []AdditionalDetails
+}
+
+func (l *detailsChangeLogger) Log(_ context.Context, _ resource.Managed, _ v1alpha1.OperationType, _ error, ad AdditionalDetails) error {
+	l.details = append(l.details, ad)
+	return nil
+}
+
+func TestReconcilerID(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		eventID  string
+		changeID string
+	}
+
+	cases := map[string]struct {
+		reason string
+		id     string
+		want   want
+	}{
+		"NoID": {
+			reason: "By default no controller ID should be included in events or change logs.",
+		},
+		"WithID": {
+			reason: "The controller ID should be included in recorded events and change log entries.",
+			id:     "cool-controller-0",
+			want: want{
+				eventID:  "cool-controller-0",
+				changeID: "cool-controller-0",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := newAnnotationRecorder()
+			cl := &detailsChangeLogger{}
+			r := NewReconciler(&fake.Manager{
+				Client: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockUpdate:       test.NewMockUpdateFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+				Scheme: fake.SchemeWith(&fake.Managed{}),
+			}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithReconcilerID(tc.id),
+				WithRecorder(rec),
+				WithChangeLogger(cl),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithCriticalAnnotationUpdater(CriticalAnnotationUpdateFn(func(_ context.Context, _ client.Object) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: false}, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{}, errBoom
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+
+			if len(*rec.recorded) == 0 {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): expected an event to be recorded", tc.reason)
+			}
+			if diff := cmp.Diff(tc.want.eventID, (*rec.recorded)[0][keyControllerID]); diff != "" {
+				t.Errorf("\nReason: %s\nEvent annotation %q: -want, +got:\n%s", tc.reason, keyControllerID, diff)
+			}
+
+			if len(cl.details) == 0 {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): expected a change log entry to be recorded", tc.reason)
+			}
+			if diff := cmp.Diff(tc.want.changeID, cl.details[0][keyControllerID]); diff != "" {
+				t.Errorf("\nReason: %s\nChange log detail %q: -want, +got:\n%s", tc.reason, keyControllerID, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerPublishDeletionDetails(t *testing.T) {
+	now := metav1.Now()
+
+	cases := map[string]struct {
+		reason  string
+		publish bool
+		want    []string
+	}{
+		"Enabled": {
+			reason:  "Connection details returned by Delete should be published, then unpublished.",
+			publish: true,
+			want:    []string{"publish:last-known", "unpublish"},
+		},
+		"Disabled": {
+			reason: "Connection details returned by Delete shouldn't be published by default.",
+			want:   []string{"unpublish"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := []string{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					mg := obj.(*fake.Managed)
+					mg.SetDeletionTimestamp(&now)
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithPublishDeletionDetails(tc.publish),
+				WithDeletionPolicyHook(RemoveFinalizerAfterDeleteRequest),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							return ExternalDelete{ConnectionDetails: ConnectionDetails{"endpoint": []byte("last-known")}}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, cd ConnectionDetails) (bool, error) {
+						calls = append(calls, "publish:"+string(cd["endpoint"]))
+						return true, nil
+					},
+					UnpublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ ConnectionDetails) error {
+						calls = append(calls, "unpublish")
+						return nil
+					},
+				}),
+				WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, calls); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerResolvedReferencesEvents(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		previous   *xpv1.Condition
+		resolveErr error
+	}
+	type want struct {
+		reasons []event.Reason
+		status  corev1.ConditionStatus
+	}
+
+	unresolved := ReferencesUnresolved(errBoom)
+	resolved := ReferencesResolved()
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"FirstResolution": {
+			reason: "We shouldn't record an event if references resolve without having previously failed.",
+			args:   args{},
+			want: want{
+				status: corev1.ConditionTrue,
+			},
+		},
+		"StillResolved": {
+			reason: "We shouldn't record an event each time references resolve.",
+			args: args{
+				previous: &resolved,
+			},
+			want: want{
+				status: corev1.ConditionTrue,
+			},
+		},
+		"FailureToSuccess": {
+			reason: "We should record an event when references resolve after previously failing.",
+			args: args{
+				previous: &unresolved,
+			},
+			want: want{
+				reasons: []event.Reason{reasonResolvedRefs},
+				status:  corev1.ConditionTrue,
+			},
+		},
+		"StillFailing": {
+			reason: "We should only record a warning event when references fail to resolve.",
+			args: args{
+				previous:   &unresolved,
+				resolveErr: errBoom,
+			},
+			want: want{
+				reasons: []event.Reason{reasonCannotResolveRefs},
+				status:  corev1.ConditionFalse,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			rec := &reasonRecorder{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					if tc.args.previous != nil {
+						obj.(*fake.Managed).SetConditions(*tc.args.previous)
+					}
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got.status = obj.(*fake.Managed).GetCondition(TypeReferencesResolved).Status
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithResolvedReferencesEvents(),
+				WithRecorder(rec),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return tc.args.resolveErr })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.reasons = rec.reasons
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerAllowedActions(t *testing.T) {
+	type actions struct {
+		Create         bool
+		Update         bool
+		LateInitialize bool
+		Delete         bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		policies xpv1.ManagementPolicies
+		o        []ReconcilerOption
+		want     actions
+	}{
+		"PoliciesDisabled": {
+			reason: "All actions should be allowed when management policies are disabled.",
+			want:   actions{Create: true, Update: true, LateInitialize: true, Delete: true},
+		},
+		"ObserveUpdate": {
+			reason:   "Only the actions allowed by the managed resource's management policies should be allowed.",
+			policies: xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionUpdate},
+			o:        []ReconcilerOption{WithManagementPolicies()},
+			want:     actions{Update: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := actions{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*fake.Managed).SetManagementPolicies(tc.policies)
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+							p := AllowedActionsFromContext(ctx)
+							got = actions{Create: p.ShouldCreate(), Update: p.ShouldUpdate(), LateInitialize: p.ShouldLateInitialize(), Delete: p.ShouldDelete()}
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nAllowedActionsFromContext(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerRequeueOnConnectionDetailsChange(t *testing.T) {
+	type args struct {
+		published bool
+		o         []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   reconcile.Result
+	}{
+		"Changed": {
+			reason: "We should requeue immediately when publishing changed the connection details.",
+			args: args{
+				published: true,
+				o:         []ReconcilerOption{WithRequeueOnConnectionDetailsChange()},
+			},
+			want: reconcile.Result{Requeue: true},
+		},
+		"Unchanged": {
+			reason: "We should requeue after the poll interval when publishing didn't change the connection details.",
+			args: args{
+				o: []ReconcilerOption{WithRequeueOnConnectionDetailsChange()},
+			},
+			want: reconcile.Result{RequeueAfter: defaultPollInterval},
+		},
+		"Disabled": {
+			reason: "We should requeue after the poll interval unless we're configured to requeue on connection details change.",
+			args: args{
+				published: true,
+			},
+			want: reconcile.Result{RequeueAfter: defaultPollInterval},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(ConnectionPublisherFns{
+					PublishConnectionFn: func(_ context.Context, _ resource.ConnectionSecretOwner, _ ConnectionDetails) (bool, error) {
+						return tc.args.published, nil
+					},
+				}),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A timestampedManaged is a managed resource that records when it was last
+// reconciled.
+type timestampedManaged struct {
+	fake.Managed
+
+	LastReconcileTime    metav1.Time `json:"lastReconcileTime"`
+	LastSyncedGeneration int64       `json:"lastSyncedGeneration"`
+}
+
+func (m *timestampedManaged) SetLastReconcileTime(t metav1.Time) { m.LastReconcileTime = t }
+func (m *timestampedManaged) SetLastSyncedGeneration(g int64)    { m.LastSyncedGeneration = g }
+
+func (m *timestampedManaged) DeepCopyObject() runtime.Object {
+	out := &timestampedManaged{}
+	j, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	_ = json.Unmarshal(j, out)
+	return out
+}
+
+func TestReconcilerLastReconcileTime(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		stamped    bool
+		generation int64
+	}
+
+	cases := map[string]struct {
+		reason     string
+		mg         resource.Managed
+		observeErr error
+		want       want
+	}{
+		"Success": {
+			reason: "The last reconcile time and synced generation should be recorded when reconciliation succeeds.",
+			mg:     &timestampedManaged{Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 3}}},
+			want:   want{stamped: true, generation: 3},
+		},
+		"Error": {
+			reason:     "The last reconcile time shouldn't be recorded when reconciliation fails.",
+			mg:         &timestampedManaged{Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 3}}},
+			observeErr: errBoom,
+			want:       want{},
+		},
+		"NotSupported": {
+			reason: "Managed resources needn't support recording the last reconcile time.",
+			mg:     &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 3}},
+			want:   want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetGeneration(tc.mg.GetGeneration())
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					if tm, ok := obj.(*timestampedManaged); ok {
+						got = want{stamped: !tm.LastReconcileTime.IsZero(), generation: tm.LastSyncedGeneration}
+					}
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(tc.mg)}, resource.ManagedKind(fake.GVK(tc.mg)),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, tc.observeErr
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerLastReconcileTimeIfChanged(t *testing.T) {
+	then := metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	type want struct {
+		updates int
+		stamped bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		synced int64
+		want   want
+	}{
+		"UpToDate": {
+			reason: "Recording the last reconcile time alone shouldn't update the status of an up-to-date managed resource when the strategy is IfChanged.",
+			synced: 3,
+			want:   want{updates: 0},
+		},
+		"GenerationChanged": {
+			reason: "The status and last reconcile time should be updated when a new generation is synced and the strategy is IfChanged.",
+			synced: 2,
+			want:   want{updates: 1, stamped: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					tm := obj.(*timestampedManaged)
+					tm.SetGeneration(3)
+					tm.SetConditions(xpv1.ReconcileSuccess())
+					tm.LastReconcileTime = then
+					tm.LastSyncedGeneration = tc.synced
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got.updates++
+					got.stamped = !obj.(*timestampedManaged).LastReconcileTime.Equal(&then)
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&timestampedManaged{})}, resource.ManagedKind(fake.GVK(&timestampedManaged{})),
+				WithStatusUpdateStrategy(StatusUpdateIfChanged),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerObserveRetry(t *testing.T) {
+	errBoom := errors.New("boom")
+	errTransient := kerrors.NewServiceUnavailable("boom")
+	backoff := wait.Backoff{Duration: time.Millisecond, Factor: 1}
+
+	type args struct {
+		errs  []error
+		block bool
+		o     []ReconcilerOption
+	}
+	type want struct {
+		calls  int
+		result reconcile.Result
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"TransientThenSuccess": {
+			reason: "Observe should be retried within a single reconcile if it returns a transient error.",
+			args: args{
+				errs: []error{errTransient, errTransient},
+				o:    []ReconcilerOption{WithObserveRetry(3, backoff)},
+			},
+			want: want{
+				calls:  3,
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+			},
+		},
+		"AttemptsExhausted": {
+			reason: "We should requeue if Observe returns a transient error on every attempt.",
+			args: args{
+				errs: []error{errTransient, errTransient, errTransient},
+				o:    []ReconcilerOption{WithObserveRetry(2, backoff)},
+			},
+			want: want{
+				calls:  2,
+				result: reconcile.Result{Requeue: true},
+			},
+		},
+		"NotTransient": {
+			reason: "Observe shouldn't be retried if it returns an error that isn't transient.",
+			args: args{
+				errs: []error{errBoom},
+				o:    []ReconcilerOption{WithObserveRetry(3, backoff)},
+			},
+			want: want{
+				calls:  1,
+				result: reconcile.Result{Requeue: true},
+			},
+		},
+		"TimeoutExceeded": {
+			reason: "Observe shouldn't be retried once the observe timeout is exceeded, even though deadline exceeded errors are transient.",
+			args: args{
+				block: true,
+				o:     []ReconcilerOption{WithObserveRetry(5, backoff), WithObserveTimeout(10 * time.Millisecond)},
+			},
+			want: want{
+				calls:  1,
+				result: reconcile.Result{Requeue: true},
+			},
+		},
+		"Disabled": {
+			reason: "Observe shouldn't be retried unless we're configured to retry it.",
+			args: args{
+				errs: []error{errTransient},
+			},
+			want: want{
+				calls:  1,
+				result: reconcile.Result{Requeue: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+							defer func() { got.calls++ }()
+							if tc.args.block {
+								<-ctx.Done()
+								return ExternalObservation{}, ctx.Err()
+							}
+							if got.calls < len(tc.args.errs) {
+								return ExternalObservation{}, tc.args.errs[got.calls]
+							}
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.result = result
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerGlobalPause(t *testing.T) {
+	type want struct {
+		calls  int
+		result reconcile.Result
+		cond   xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      []ReconcilerOption
+		paused []bool
+		want   want
+	}{
+		"Paused": {
+			reason: "Reconciliation should be short-circuited while globally paused, and the managed resource polled until it's no longer paused.",
+			paused: []bool{true},
+			want: want{
+				calls:  0,
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+				cond:   xpv1.ReconcilePaused().WithMessage(msgPausedGlobally),
+			},
+		},
+		"PausedPollIntervalHook": {
+			reason: "The managed resource should be polled at the interval returned by the poll interval hook while globally paused.",
+			o: []ReconcilerOption{WithPollIntervalHook(func(_ resource.Managed, pollInterval time.Duration) time.Duration {
+				return 2 * pollInterval
+			})},
+			paused: []bool{true},
+			want: want{
+				calls:  0,
+				result: reconcile.Result{RequeueAfter: 2 * defaultPollInterval},
+				cond:   xpv1.ReconcilePaused().WithMessage(msgPausedGlobally),
+			},
+		},
+		"Resumed": {
+			reason: "Reconciliation should resume once it's no longer globally paused.",
+			paused: []bool{true, false},
+			want: want{
+				calls:  1,
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+				cond:   xpv1.ReconcileSuccess(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			paused := false
+			mg := &fake.Managed{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *mg.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					mg = obj.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+			}
+			o := append([]ReconcilerOption{
+				WithGlobalPause(func() bool { return paused }),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							got.calls++
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			for _, p := range tc.paused {
+				paused = p
+				result, err := r.Reconcile(context.Background(), reconcile.Request{})
+				if err != nil {
+					t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+				}
+				got.result = result
+			}
+			got.cond = mg.GetCondition(xpv1.TypeSynced)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerExternalNameValidation(t *testing.T) {
+	rules := meta.ExternalNameRules{MaxLength: 8}
+
+	type want struct {
+		created bool
+		result  reconcile.Result
+		cond    xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		name   string
+		want   want
+	}{
+		"Valid": {
+			reason: "The external resource should be created if its external name is valid.",
+			name:   "cool",
+			want: want{
+				created: true,
+				result:  reconcile.Result{Requeue: true},
+				cond:    xpv1.ReconcileSuccess(),
+			},
+		},
+		"Invalid": {
+			reason: "The external resource shouldn't be created, and we shouldn't requeue, if its external name is invalid.",
+			name:   "cool-database",
+			want: want{
+				created: false,
+				result:  reconcile.Result{},
+				cond:    xpv1.ReconcileError(errors.Wrap(errors.Errorf("external name %q is %d characters long, which exceeds the maximum of %d", "cool-database", 13, 8), errReconcileCreate)),
+			},
+		},
+		"Unset": {
+			reason: "The external resource should be created if it doesn't yet have an external name.",
+			want: want{
+				created: true,
+				result:  reconcile.Result{Requeue: true},
+				cond:    xpv1.ReconcileSuccess(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			mg := &fake.Managed{}
+			if tc.name != "" {
+				meta.SetExternalName(mg, tc.name)
+			}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *mg.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got.cond = obj.(*fake.Managed).GetCondition(xpv1.TypeSynced)
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithExternalNameValidation(rules),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: false}, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							got.created = true
+							return ExternalCreation{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			got.result = result
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateExternalName(t *testing.T) {
+	rules := meta.ExternalNameRules{MaxLength: 8}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   error
+	}{
+		"AnnotationInvalid": {
+			reason: "An external name stored in the annotation should be validated.",
+			mg: func() resource.Managed {
+				mg := &fake.Managed{}
+				meta.SetExternalName(mg, "cool-database")
+				return mg
+			}(),
+			want: errors.Errorf("external name %q is %d characters long, which exceeds the maximum of %d", "cool-database", 13, 8),
+		},
+		"AccessorInvalid": {
+			reason: "An external name stored using an ExternalNameAccessor should be validated.",
+			mg:     &externalNamedManaged{ExternalNameAccessor: fake.ExternalNameAccessor{ExternalName: "cool-database"}},
+			want:   errors.Errorf("external name %q is %d characters long, which exceeds the maximum of %d", "cool-database", 13, 8),
+		},
+		"AccessorValid": {
+			reason: "A valid external name stored using an ExternalNameAccessor should pass validation.",
+			mg:     &externalNamedManaged{ExternalNameAccessor: fake.ExternalNameAccessor{ExternalName: "cool"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &Reconciler{externalNameRules: &rules}
+			err := r.validateExternalName(tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nr.validateExternalName(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerReferenceResolverOptions(t *testing.T) {
+	type want struct {
+		policy       ReferenceResolutionPolicy
+		detectCycles bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      []ReconcilerOption
+		want   want
+	}{
+		"PolicyThenCycleDetection": {
+			reason: "Enabling cycle detection should preserve an earlier reference resolution policy.",
+			o:      []ReconcilerOption{WithReferenceResolutionPolicy(ReferenceResolutionIfNotResolved), WithReferenceCycleDetection()},
+			want:   want{policy: ReferenceResolutionIfNotResolved, detectCycles: true},
+		},
+		"CycleDetectionThenPolicy": {
+			reason: "Setting a reference resolution policy should preserve earlier cycle detection.",
+			o:      []ReconcilerOption{WithReferenceCycleDetection(), WithReferenceResolutionPolicy(ReferenceResolutionIfNotResolved)},
+			want:   want{policy: ReferenceResolutionIfNotResolved, detectCycles: true},
+		},
+		"CustomResolverReplaced": {
+			reason: "Enabling cycle detection should replace a ReferenceResolver that isn't an APISimpleReferenceResolver.",
+			o: []ReconcilerOption{
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithReferenceCycleDetection(),
+			},
+			want: want{policy: ReferenceResolutionAlways, detectCycles: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewReconciler(&fake.Manager{Client: &test.MockClient{}, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), tc.o...)
+			a, ok := r.managed.ReferenceResolver.(*APISimpleReferenceResolver)
+			if !ok {
+				t.Fatalf("\nReason: %s\nNewReconciler(...): want *APISimpleReferenceResolver, got %T", tc.reason, r.managed.ReferenceResolver)
+			}
+			got := want{policy: a.policy, detectCycles: a.detectCycles}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nReason: %s\nNewReconciler(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerResultHook(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type observed struct {
+		calls   int
+		req     reconcile.Request
+		managed bool
+		result  reconcile.Result
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		want   observed
+	}{
+		"ErrorPath": {
+			reason: "The result hook should observe the error returned when the managed resource can't be read.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			want: observed{calls: 1, req: reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}, err: errors.Wrap(errBoom, errGetManaged)},
+		},
+		"NotFound": {
+			reason: "The result hook should be called with a nil managed resource if it doesn't exist.",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+			},
+			want: observed{calls: 1, req: reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}},
+		},
+		"SuccessPath": {
+			reason: "The result hook should observe the result of a successful reconcile.",
+			c: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			},
+			want: observed{calls: 1, req: reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}, managed: true, result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := observed{}
+			r := NewReconciler(&fake.Manager{Client: tc.c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithResultHook(func(_ context.Context, req reconcile.Request, mg resource.Managed, result reconcile.Result, err error) {
+					got = observed{calls: got.calls + 1, req: req, managed: mg != nil, result: result, err: err}
+				}),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			result, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}})
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(observed{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nResultHook(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(result, got.result); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -returned, +observed:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(err, got.err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -returned error, +observed error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A requeueReasonRecorder is a logging.Logger that records the value of any
+// requeue-reason key logged at debug level.
+type requeueReasonRecorder struct {
+	logging.Logger
+
+	reasons *[]any
+}
+
+func (l requeueReasonRecorder) Debug(_ string, kv ...any) {
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i] == "requeue-reason" {
+			*l.reasons = append(*l.reasons, kv[i+1])
+		}
+	}
+}
+
+func (l requeueReasonRecorder) WithValues(_ ...any) logging.Logger { return l }
+
+func TestReconcilerRequeueReason(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+
+	type args struct {
+		mg  *fake.Managed
+		obs ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []any
+	}{
+		"Error": {
+			reason: "A reconcile that fails should be requeued due to an error.",
+			args:   args{mg: &fake.Managed{}, err: errBoom},
+			want:   []any{requeueReasonError},
+		},
+		"Poll": {
+			reason: "A reconcile of an up-to-date external resource should be requeued to poll it.",
+			args:   args{mg: &fake.Managed{}, obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			want:   []any{requeueReasonPoll},
+		},
+		"Created": {
+			reason: "A reconcile that creates an external resource should be requeued because it was created.",
+			args:   args{mg: &fake.Managed{}, obs: ExternalObservation{ResourceExists: false}},
+			want:   []any{requeueReasonCreated},
+		},
+		"Updated": {
+			reason: "A reconcile that updates an external resource should be requeued because it was updated.",
+			args:   args{mg: &fake.Managed{}, obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			want:   []any{requeueReasonUpdated},
+		},
+		"Deleting": {
+			reason: "A reconcile that deletes an external resource should be requeued because it's deleting.",
+			args:   args{mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}}, obs: ExternalObservation{ResourceExists: true}},
+			want:   []any{requeueReasonDeleting},
+		},
+		"Paused": {
+			reason: "A reconcile of a paused managed resource should be requeued because it's paused.",
+			args:   args{mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyReconciliationPaused: "true"}}}},
+			want:   []any{requeueReasonPaused},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := []any{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *tc.args.mg.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithLogger(requeueReasonRecorder{Logger: logging.NewNopLogger(), reasons: &got}),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.args.obs, tc.args.err
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{}, nil
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							return ExternalDelete{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			_, _ = r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want requeue-reason, +got requeue-reason:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerSpecValidator(t *testing.T) {
+	errInvalid := errors.New("spec.size must be positive")
+
+	type step struct {
+		generation int64
+		valid      bool
+	}
+	type want struct {
+		observed int
+		result   reconcile.Result
+		cond     xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		steps  []step
+		want   want
+	}{
+		"Invalid": {
+			reason: "An invalid spec should block calls to the external system, and shouldn't be requeued.",
+			steps:  []step{{generation: 1, valid: false}},
+			want: want{
+				observed: 0,
+				result:   reconcile.Result{},
+				cond:     xpv1.ReconcileError(errors.Wrap(errInvalid, errValidateSpec)),
+			},
+		},
+		"StillInvalid": {
+			reason: "A managed resource whose spec was invalid shouldn't be reconciled again until its spec changes.",
+			steps:  []step{{generation: 1, valid: false}, {generation: 1, valid: true}},
+			want: want{
+				observed: 0,
+				result:   reconcile.Result{},
+				cond:     xpv1.ReconcileError(errors.Wrap(errInvalid, errValidateSpec)),
+			},
+		},
+		"Fixed": {
+			reason: "A managed resource whose spec was fixed should be reconciled.",
+			steps:  []step{{generation: 1, valid: false}, {generation: 2, valid: true}},
+			want: want{
+				observed: 1,
+				result:   reconcile.Result{RequeueAfter: defaultPollInterval},
+				cond:     xpv1.ReconcileSuccess(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			valid := false
+			mg := &fake.Managed{}
+			store := func(obj client.Object) error {
+				mg = obj.DeepCopyObject().(*fake.Managed)
+				return nil
+			}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *mg.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(nil, store),
+				MockPatch:        test.NewMockPatchFn(nil, store),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, store),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithSpecValidator(func(_ context.Context, _ resource.Managed) error {
+					if !valid {
+						return errInvalid
+					}
+					return nil
+				}),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							got.observed++
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			for _, s := range tc.steps {
+				mg.SetGeneration(s.generation)
+				valid = s.valid
+				result, err := r.Reconcile(context.Background(), reconcile.Request{})
+				if err != nil {
+					t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+				}
+				got.result = result
+			}
+			got.cond = mg.GetCondition(xpv1.TypeSynced)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerFinalizerName(t *testing.T) {
+	now := metav1.Now()
+
+	type args struct {
+		mg  *fake.Managed
+		obs ExternalObservation
+		o   []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []string
+	}{
+		"AddDefault": {
+			reason: "The default finalizer should be added if no finalizer name is configured.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: []string{FinalizerName},
+		},
+		"AddConfigured": {
+			reason: "The configured finalizer should be added instead of the default.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				o:   []ReconcilerOption{WithFinalizerName("cool.example.org/finalizer")},
+			},
+			want: []string{"cool.example.org/finalizer"},
+		},
+		"RemoveConfigured": {
+			reason: "The configured finalizer should be removed, leaving the default alone.",
+			args: args{
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: &now,
+					Finalizers:        []string{FinalizerName, "cool.example.org/finalizer"},
+				}},
+				obs: ExternalObservation{ResourceExists: false},
+				o:   []ReconcilerOption{WithFinalizerName("cool.example.org/finalizer")},
+			},
+			want: []string{FinalizerName},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *tc.args.mg.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					got = obj.GetFinalizers()
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.args.obs, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+			}, tc.args.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want finalizers, +got finalizers:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerPhaseTimeouts(t *testing.T) {
+	now := metav1.Now()
+	phaseTimeout := 10 * time.Millisecond
+
+	type args struct {
+		mg  *fake.Managed
+		obs ExternalObservation
+		o   []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   map[string]error
+	}{
+		"NoPhaseTimeout": {
+			reason: "Phases without a timeout should be bounded only by the reconcile timeout.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+			want: map[string]error{"Observe": nil, "Update": nil},
+		},
+		"ObserveTimeout": {
+			reason: "An Observe timeout should cancel the Observe call.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				o:   []ReconcilerOption{WithObserveTimeout(phaseTimeout)},
+			},
+			want: map[string]error{"Observe": context.DeadlineExceeded},
+		},
+		"CreateTimeout": {
+			reason: "A Create timeout should cancel only the Create call.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: false},
+				o:   []ReconcilerOption{WithCreateTimeout(phaseTimeout)},
+			},
+			want: map[string]error{"Observe": nil, "Create": context.DeadlineExceeded},
+		},
+		"UpdateTimeout": {
+			reason: "An Update timeout should cancel only the Update call.",
+			args: args{
+				mg:  &fake.Managed{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				o:   []ReconcilerOption{WithUpdateTimeout(phaseTimeout)},
+			},
+			want: map[string]error{"Observe": nil, "Update": context.DeadlineExceeded},
+		},
+		"DeleteTimeout": {
+			reason: "A Delete timeout should cancel only the Delete call.",
+			args: args{
+				mg:  &fake.Managed{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}},
+				obs: ExternalObservation{ResourceExists: true},
+				o:   []ReconcilerOption{WithDeleteTimeout(phaseTimeout)},
+			},
+			want: map[string]error{"Observe": nil, "Delete": context.DeadlineExceeded},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := map[string]error{}
+
+			// call blocks until the supplied context is done if it is bounded
+			// by a phase timeout, and records the context's error.
+			call := func(ctx context.Context, phase string) error {
+				if d, ok := ctx.Deadline(); ok && time.Until(d) <= phaseTimeout {
+					<-ctx.Done()
+				}
+				got[phase] = ctx.Err()
+				return ctx.Err()
+			}
+
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *tc.args.mg
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockPatch:        test.NewMockPatchFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithTimeout(time.Hour),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.args.obs, call(ctx, "Observe")
+						},
+						CreateFn: func(ctx context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return ExternalCreation{}, call(ctx, "Create")
+						},
+						UpdateFn: func(ctx context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{}, call(ctx, "Update")
+						},
+						DeleteFn: func(ctx context.Context, _ resource.Managed) (ExternalDelete, error) {
+							return ExternalDelete{}, call(ctx, "Delete")
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want phase errors, +got phase errors:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerConditionPreservingManagedUpdater(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		u      func(c client.Client) ManagedUpdater
+		want   []xpv1.Condition
+	}{
+		"ConditionsLost": {
+			reason: "By default conditions set while observing are lost when a late initialized managed resource is updated.",
+			u:      func(c client.Client) ManagedUpdater { return NewAPIUpdatingManagedUpdater(c) },
+			want:   []xpv1.Condition{xpv1.ReconcileSuccess()},
+		},
+		"ConditionsPreserved": {
+			reason: "Conditions set while observing should survive the update of a late initialized managed resource.",
+			u: func(c client.Client) ManagedUpdater {
+				return NewConditionPreservingManagedUpdater(NewAPIUpdatingManagedUpdater(c))
 			},
+			want: []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()},
 		},
-		"CreateFailureWithChangeLogs": {
-			reason: "Failed managed resource creation should send a create change log entry with the error when change logs are enabled.",
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []xpv1.Condition
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+				// The API server responds to an update with its own view of
+				// the managed resource's status.
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					obj.(*fake.Managed).ConditionedStatus = xpv1.ConditionedStatus{}
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got = obj.(*fake.Managed).Conditions
+					return nil
+				}),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+							mg.SetConditions(xpv1.Available())
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				WithManagedUpdater(tc.u(c)),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerRequeueAfterHint(t *testing.T) {
+	type args struct {
+		obs      ExternalObservation
+		creation ExternalCreation
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   reconcile.Result
+	}{
+		"UpToDateHint": {
+			reason: "A positive observation requeue hint should override the poll interval when the external resource is up to date.",
 			args: args{
-				m: &fake.Manager{
-					Client: &test.MockClient{
-						MockGet:          test.NewMockGetFn(nil),
-						MockUpdate:       test.NewMockUpdateFn(nil),
-						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
-					},
-					Scheme: fake.SchemeWith(&fake.Managed{}),
-				},
-				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
-				o: []ReconcilerOption{
-					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
-						c := &ExternalClientFns{
-							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
-								// resource doesn't exist, which should trigger a create operation
-								return ExternalObservation{ResourceExists: false, ResourceUpToDate: false}, nil
-							},
-							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
-								// return an error from Create to simulate a failed creation
-								return ExternalCreation{}, errBoom
-							},
-							DisconnectFn: func(_ context.Context) error {
-								return nil
-							},
-						}
-						return c, nil
-					})),
-				},
-				c: &changeLogServiceClient{},
-			},
-			want: want{
-				callCount:  1,
-				opType:     v1alpha1.OperationType_OPERATION_TYPE_CREATE,
-				errMessage: errBoom.Error(),
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: true, RequeueAfter: 10 * time.Second},
 			},
+			want: reconcile.Result{RequeueAfter: 10 * time.Second},
 		},
-		"UpdateSuccessfulWithChangeLogs": {
-			reason: "Successful managed resource update should send an update change log entry when change logs are enabled.",
+		"UpToDateNegativeHint": {
+			reason: "A negative observation requeue hint should be ignored.",
 			args: args{
-				m: &fake.Manager{
-					Client: &test.MockClient{
-						MockGet:          test.NewMockGetFn(nil),
-						MockUpdate:       test.NewMockUpdateFn(nil),
-						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
-					},
-					Scheme: fake.SchemeWith(&fake.Managed{}),
-				},
-				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
-				o: []ReconcilerOption{
-					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
-						c := &ExternalClientFns{
-							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
-								// resource exists but isn't up to date, which should trigger an update operation
-								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
-							},
-							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
-								return ExternalUpdate{}, nil
-							},
-							DisconnectFn: func(_ context.Context) error {
-								return nil
-							},
-						}
-						return c, nil
-					})),
-				},
-				c: &changeLogServiceClient{},
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: true, RequeueAfter: -10 * time.Second},
 			},
-			want: want{
-				callCount:  1,
-				opType:     v1alpha1.OperationType_OPERATION_TYPE_UPDATE,
-				errMessage: "",
+			want: reconcile.Result{RequeueAfter: defaultPollInterval},
+		},
+		"UpdatedHint": {
+			reason: "A positive observation requeue hint should override the poll interval after the external resource is updated.",
+			args: args{
+				obs: ExternalObservation{ResourceExists: true, ResourceUpToDate: false, RequeueAfter: 10 * time.Second},
 			},
+			want: reconcile.Result{RequeueAfter: 10 * time.Second},
 		},
-		"UpdateFailureWithChangeLogs": {
-			reason: "Failed managed resource update should send an update change log entry with the error when change logs are enabled.",
+		"CreatedHint": {
+			reason: "A positive creation requeue hint should override the poll interval after the external resource is created.",
 			args: args{
-				m: &fake.Manager{
-					Client: &test.MockClient{
-						MockGet:          test.NewMockGetFn(nil),
-						MockUpdate:       test.NewMockUpdateFn(nil),
-						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
-					},
-					Scheme: fake.SchemeWith(&fake.Managed{}),
-				},
-				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
-				o: []ReconcilerOption{
-					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
-						c := &ExternalClientFns{
-							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
-								// resource exists but isn't up to date, which should trigger an update operation
-								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
-							},
-							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
-								// return an error from Update to simulate a failed update
-								return ExternalUpdate{}, errBoom
-							},
-							DisconnectFn: func(_ context.Context) error {
-								return nil
-							},
-						}
-						return c, nil
-					})),
-				},
-				c: &changeLogServiceClient{},
+				obs:      ExternalObservation{ResourceExists: false},
+				creation: ExternalCreation{RequeueAfter: 5 * time.Second},
 			},
-			want: want{
-				callCount:  1,
-				opType:     v1alpha1.OperationType_OPERATION_TYPE_UPDATE,
-				errMessage: errBoom.Error(),
+			want: reconcile.Result{RequeueAfter: 5 * time.Second},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.args.obs, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							return tc.args.creation, nil
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							return ExternalUpdate{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerPausedReason(t *testing.T) {
+	until := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	type args struct {
+		mg          *fake.Managed
+		globalPause bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"GlobalPause": {
+			reason: "The ReconcilePaused condition should explain that reconciliation is globally paused.",
+			args: args{
+				mg:          fake.NewManaged(),
+				globalPause: true,
 			},
+			want: msgPausedGlobally,
 		},
-		"DeleteSuccessfulWithChangeLogs": {
-			reason: "Successful managed resource delete should send a delete change log entry when change logs are enabled.",
+		"PauseAnnotation": {
+			reason: "The ReconcilePaused condition should explain that reconciliation is paused by the pause annotation.",
 			args: args{
-				m: &fake.Manager{
-					Client: &test.MockClient{
-						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
-							// set a deletion timestamp, which should trigger a delete operation
-							mg := obj.(*fake.Managed)
-							mg.SetDeletionTimestamp(&now)
-							mg.SetDeletionPolicy(xpv1.DeletionDelete)
-							return nil
-						}),
-						MockUpdate:       test.NewMockUpdateFn(nil),
-						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
-					},
-					Scheme: fake.SchemeWith(&fake.Managed{}),
-				},
-				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
-				o: []ReconcilerOption{
-					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
-						c := &ExternalClientFns{
-							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
-								// resource exists but we set a deletion timestamp above, which should trigger a delete operation
-								return ExternalObservation{ResourceExists: true}, nil
-							},
-							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
-								return ExternalDelete{}, nil
-							},
-							DisconnectFn: func(_ context.Context) error {
-								return nil
-							},
-						}
-						return c, nil
-					})),
-				},
-				c: &changeLogServiceClient{},
+				mg: fake.NewManaged(fake.WithAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})),
 			},
-			want: want{
-				callCount:  1,
-				opType:     v1alpha1.OperationType_OPERATION_TYPE_DELETE,
-				errMessage: "",
+			want: msgPausedByAnnotation,
+		},
+		"PausedUntilAnnotation": {
+			reason: "The ReconcilePaused condition should explain that reconciliation is paused until a particular time.",
+			args: args{
+				mg: fake.NewManaged(fake.WithAnnotations(map[string]string{meta.AnnotationKeyReconciliationPausedUntil: until.Format(time.RFC3339)})),
 			},
+			want: fmt.Sprintf(msgFmtPausedUntil, until.Format(time.RFC3339)),
 		},
-		"DeleteFailureWithChangeLogs": {
-			reason: "Failed managed resource delete should send a delete change log entry with the error when change logs are enabled.",
+		"EmptyManagementPolicies": {
+			reason: "The ReconcilePaused condition should explain that reconciliation is paused by empty management policies.",
 			args: args{
-				m: &fake.Manager{
-					Client: &test.MockClient{
-						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
-							// set a deletion timestamp, which should trigger a delete operation
-							mg := obj.(*fake.Managed)
-							mg.SetDeletionTimestamp(&now)
-							mg.SetDeletionPolicy(xpv1.DeletionDelete)
-							return nil
-						}),
-						MockUpdate:       test.NewMockUpdateFn(nil),
-						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error { return nil }),
-					},
-					Scheme: fake.SchemeWith(&fake.Managed{}),
-				},
-				mg: resource.ManagedKind(fake.GVK(&fake.Managed{})),
-				o: []ReconcilerOption{
-					WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
-						c := &ExternalClientFns{
-							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
-								// resource exists but we set a deletion timestamp above, which should trigger a delete operation
-								return ExternalObservation{ResourceExists: true}, nil
-							},
-							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
-								// return an error from Delete to simulate a failed delete
-								return ExternalDelete{}, errBoom
-							},
-							DisconnectFn: func(_ context.Context) error {
-								return nil
-							},
-						}
-						return c, nil
-					})),
-				},
-				c: &changeLogServiceClient{},
+				mg: fake.NewManaged(fake.WithPolicies()),
 			},
-			want: want{
-				callCount:  1,
-				opType:     v1alpha1.OperationType_OPERATION_TYPE_DELETE,
-				errMessage: errBoom.Error(),
+			want: msgPausedByPolicies,
+		},
+		"PausedReasonAnnotation": {
+			reason: "The ReconcilePaused condition should include the reason recorded by the paused reason annotation.",
+			args: args{
+				mg: fake.NewManaged(fake.WithAnnotations(map[string]string{
+					meta.AnnotationKeyReconciliationPaused:       "true",
+					meta.AnnotationKeyReconciliationPausedReason: "Database migration in progress (alice)",
+				})),
 			},
+			want: msgPausedByAnnotation + ": Database migration in progress (alice)",
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			tc.args.o = append(tc.args.o, WithChangeLogger(NewGRPCChangeLogger(tc.args.c, WithProviderVersion("provider-cool:v9.99.999"))))
-			r := NewReconciler(tc.args.m, tc.args.mg, tc.args.o...)
-			r.Reconcile(context.Background(), reconcile.Request{})
-
-			if diff := cmp.Diff(tc.want.callCount, len(tc.args.c.requests)); diff != "" {
-				t.Errorf("\nReason: %s\nr.Reconcile(...): -want callCount, +got callCount:\n%s", tc.reason, diff)
+			var got xpv1.Condition
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					*obj.(*fake.Managed) = *tc.args.mg.DeepCopyObject().(*fake.Managed)
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got = obj.(*fake.Managed).GetCondition(xpv1.TypeSynced)
+					return nil
+				}),
 			}
-
-			if diff := cmp.Diff(tc.want.opType, tc.args.c.requests[0].GetEntry().GetOperation()); diff != "" {
-				t.Errorf("\nReason: %s\nr.Reconcile(...): -want opType, +got opType:\n%s", tc.reason, diff)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithManagementPolicies(),
+				WithGlobalPause(func() bool { return tc.args.globalPause }),
+			)
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
 			}
-
-			if diff := cmp.Diff(tc.want.errMessage, tc.args.c.requests[0].GetEntry().GetErrorMessage()); diff != "" {
-				t.Errorf("\nReason: %s\nr.Reconcile(...): -want errMessage, +got errMessage:\n%s", tc.reason, diff)
+			if diff := cmp.Diff(xpv1.ReconcilePaused().WithMessage(tc.want), got, test.EquateConditions()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want condition, +got condition:\n%s", tc.reason, diff)
 			}
 		})
 	}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errGetGVK               = "cannot determine the kind of the managed resource"
+	errFmtNoConnectorForGVK = "no external connecter is configured for managed resource kind %q"
+)
+
+// A GVKRoutingConnectorOption configures a GVKRoutingConnector.
+type GVKRoutingConnectorOption func(c *GVKRoutingConnector)
+
+// WithRoutingScheme configures the scheme a GVKRoutingConnector uses to
+// determine the kind of managed resources that don't specify their kind.
+// Typed objects read from the API server often don't.
+func WithRoutingScheme(s *runtime.Scheme) GVKRoutingConnectorOption {
+	return func(c *GVKRoutingConnector) {
+		c.scheme = s
+	}
+}
+
+// A GVKRoutingConnector routes each managed resource to an ExternalConnecter
+// per the managed resource's group, version, and kind. It allows one
+// controller to manage several kinds of managed resource that each use a
+// different ExternalClient.
+type GVKRoutingConnector struct {
+	connecters map[schema.GroupVersionKind]ExternalConnecter
+	scheme     *runtime.Scheme
+}
+
+// NewGVKRoutingConnector returns an ExternalConnecter that routes each managed
+// resource to the supplied ExternalConnecter for its kind.
+func NewGVKRoutingConnector(c map[schema.GroupVersionKind]ExternalConnecter, o ...GVKRoutingConnectorOption) *GVKRoutingConnector {
+	rc := &GVKRoutingConnector{connecters: c}
+	for _, fn := range o {
+		fn(rc)
+	}
+	return rc
+}
+
+// Connect to the provider specified by the supplied managed resource using the
+// ExternalConnecter for its kind. It returns an error if there's no
+// ExternalConnecter for its kind.
+func (c *GVKRoutingConnector) Connect(ctx context.Context, mg resource.Managed) (ExternalClient, error) {
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() && c.scheme != nil {
+		var err error
+		if gvk, err = apiutil.GVKForObject(mg, c.scheme); err != nil {
+			return nil, errors.Wrap(err, errGetGVK)
+		}
+	}
+	ec, ok := c.connecters[gvk]
+	if !ok {
+		return nil, errors.Errorf(errFmtNoConnectorForGVK, gvk)
+	}
+	return ec.Connect(ctx, mg)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// An otherManaged is a managed resource of a different kind than fake.Managed.
+type otherManaged struct {
+	fake.Managed
+}
+
+func TestGVKRoutingConnector(t *testing.T) {
+	// namedConnecter returns an ExternalConnecter that records its name when
+	// it's used to connect.
+	namedConnecter := func(name string, used *string) ExternalConnecter {
+		return ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			*used = name
+			return &ExternalClientFns{}, nil
+		})
+	}
+	scheme := fake.SchemeWith(&fake.Managed{}, &otherManaged{})
+
+	type want struct {
+		used string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		o      []GVKRoutingConnectorOption
+		want   want
+	}{
+		"FirstKind": {
+			reason: "A managed resource should be connected using the connecter for its kind.",
+			mg:     &fake.Managed{},
+			o:      []GVKRoutingConnectorOption{WithRoutingScheme(scheme)},
+			want:   want{used: "managed"},
+		},
+		"SecondKind": {
+			reason: "A managed resource should be connected using the connecter for its kind.",
+			mg:     &otherManaged{},
+			o:      []GVKRoutingConnectorOption{WithRoutingScheme(scheme)},
+			want:   want{used: "other"},
+		},
+		"NoConnecterForKind": {
+			reason: "We should return an error if there's no connecter for the managed resource's kind.",
+			mg:     &fake.Managed{},
+			want:   want{err: errors.Errorf(errFmtNoConnectorForGVK, schema.GroupVersionKind{})},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := NewGVKRoutingConnector(map[schema.GroupVersionKind]ExternalConnecter{
+				fake.GVK(&fake.Managed{}): namedConnecter("managed", &got.used),
+				fake.GVK(&otherManaged{}): namedConnecter("other", &got.used),
+			}, tc.o...)
+			_, got.err = c.Connect(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nc.Connect(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errGetStatusConditions = "cannot get status conditions"
+)
+
+// A StatusUpdateStrategy determines when the Reconciler updates the status of
+// a managed resource.
+type StatusUpdateStrategy string
+
+const (
+	// StatusUpdateAlways updates the status of a managed resource at the end
+	// of every reconcile, even if it did not change.
+	StatusUpdateAlways StatusUpdateStrategy = "Always"
+
+	// StatusUpdateIfChanged updates the status of a managed resource only if
+	// it changed since the managed resource was read (or since its status was
+	// last updated).
+	StatusUpdateIfChanged StatusUpdateStrategy = "IfChanged"
+)
+
+// updateStatusAndReturn updates the status of the supplied managed resource
+// using the supplied writer, then returns the supplied result along with any
+// error encountered updating the status. The Reconciler uses it to return
+// after it sets the conditions of a managed resource, so that the result and
+// the status update stay consistent across every return path.
+func updateStatusAndReturn(ctx context.Context, w client.SubResourceWriter, mg resource.Managed, result reconcile.Result) (reconcile.Result, error) {
+	return result, errors.Wrap(w.Update(ctx, mg), errUpdateManagedStatus)
+}
+
+// An ifChangedStatusWriter skips status updates that would not change the
+// status of the supplied object.
+type ifChangedStatusWriter struct {
+	client.SubResourceWriter
+
+	snapshot []byte
+}
+
+// newIfChangedStatusWriter returns a status writer that only updates the
+// status of the supplied object if it has changed since this function was
+// called.
+func newIfChangedStatusWriter(w client.SubResourceWriter, obj client.Object) *ifChangedStatusWriter {
+	return &ifChangedStatusWriter{SubResourceWriter: w, snapshot: statusSnapshot(obj)}
+}
+
+// Update the status of the supplied object, unless it is unchanged.
+func (w *ifChangedStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	s := statusSnapshot(obj)
+	if s != nil && bytes.Equal(s, w.snapshot) {
+		return nil
+	}
+	if err := w.SubResourceWriter.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	w.snapshot = statusSnapshot(obj)
+	return nil
+}
+
+// statusSnapshot returns a serialization of everything but the metadata and
+// spec of the supplied object, which includes its status. Status updates don't
+// change the metadata or spec of an object, so neither need be compared. It
+// returns nil if the object can't be serialized.
+func statusSnapshot(obj client.Object) []byte {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil
+	}
+	for _, f := range []string{"apiVersion", "kind", "metadata", "spec"} {
+		delete(u, f)
+	}
+	// Maps are serialized with sorted keys, so the same status always results
+	// in the same bytes.
+	b, err := json.Marshal(u)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// A lastReconcileTimeStatusWriter records when a managed resource was last
+// successfully reconciled, if the managed resource supports it. The Reconciler
+// wraps it in any writer that skips unchanged status updates, so that the time
+// is only recorded when the status is updated anyway. Otherwise recording the
+// time would change the status each time the managed resource is reconciled.
+type lastReconcileTimeStatusWriter struct {
+	client.SubResourceWriter
+
+	clock clock.PassiveClock
+}
+
+// Update the status of the supplied object. If the object is a
+// LastReconcileTimeSetter and its Synced condition indicates it was
+// successfully reconciled, the current time is recorded first.
+func (w *lastReconcileTimeStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if s, ok := reconcileSucceeded(obj); ok {
+		s.SetLastReconcileTime(metav1.NewTime(w.clock.Now()))
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+// A lastSyncedGenerationStatusWriter records the generation of a managed
+// resource that was last successfully reconciled, if the managed resource
+// supports it.
+type lastSyncedGenerationStatusWriter struct {
+	client.SubResourceWriter
+}
+
+// Update the status of the supplied object. If the object is a
+// LastReconcileTimeSetter and its Synced condition indicates it was
+// successfully reconciled, its generation is recorded first.
+func (w *lastSyncedGenerationStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if s, ok := reconcileSucceeded(obj); ok {
+		s.SetLastSyncedGeneration(obj.GetGeneration())
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+// reconcileSucceeded returns the supplied object as a LastReconcileTimeSetter
+// if it is one, and its Synced condition indicates it was successfully
+// reconciled.
+func reconcileSucceeded(obj client.Object) (lastReconcileTimeSetterConditioned, bool) {
+	s, ok := obj.(lastReconcileTimeSetterConditioned)
+	if !ok {
+		return nil, false
+	}
+	c := s.GetCondition(xpv1.TypeSynced)
+	return s, c.Status == corev1.ConditionTrue && c.Reason == xpv1.ReasonReconcileSuccess
+}
+
+type lastReconcileTimeSetterConditioned interface {
+	resource.LastReconcileTimeSetter
+	resource.Conditioned
+}
+
+// A ConditionTransformer transforms the status conditions of a managed
+// resource before they're persisted. It's passed all of the managed resource's
+// conditions, and returns the conditions to set. Returned conditions replace
+// any existing condition of the same type; existing conditions that aren't
+// returned are left unchanged.
+type ConditionTransformer func(mg resource.Managed, c []xpv1.Condition) []xpv1.Condition
+
+// A conditionTransformingStatusWriter transforms the status conditions of a
+// managed resource before updating its status.
+type conditionTransformingStatusWriter struct {
+	client.SubResourceWriter
+
+	transform ConditionTransformer
+	clock     clock.PassiveClock
+
+	// persisted are the conditions of the managed resource as they were read,
+	// or as they were last written.
+	persisted []xpv1.Condition
+}
+
+// newConditionTransformingStatusWriter returns a status writer that transforms
+// the conditions of the supplied managed resource using the supplied function.
+// It must be called before the Reconciler sets any conditions, so that it can
+// snapshot the conditions as they were read.
+func newConditionTransformingStatusWriter(w client.SubResourceWriter, mg resource.Managed, fn ConditionTransformer, c clock.PassiveClock) *conditionTransformingStatusWriter {
+	// We don't fail to construct the writer if we can't get conditions; we'll
+	// fail to transform them at update time instead.
+	persisted, _ := conditionsOf(mg)
+	return &conditionTransformingStatusWriter{
+		SubResourceWriter: w,
+		transform:         fn,
+		clock:             c,
+		persisted:         append([]xpv1.Condition(nil), persisted...),
+	}
+}
+
+// Update the status of the supplied object, transforming its conditions first
+// if it is a managed resource.
+func (w *conditionTransformingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+	if err := transformConditions(mg, w.transform, w.persisted, metav1.NewTime(w.clock.Now())); err != nil {
+		return err
+	}
+	if err := w.SubResourceWriter.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	if cs, err := conditionsOf(mg); err == nil {
+		w.persisted = append([]xpv1.Condition(nil), cs...)
+	}
+	return nil
+}
+
+// transformConditions sets the conditions returned by the supplied transformer
+// on the supplied managed resource. The transformer can't break the semantics
+// of a condition's last transition time; a returned condition keeps the last
+// transition time of the persisted condition of its type unless its status
+// changed, and conditions without a last transition time get the supplied
+// current time.
+//
+// The Reconciler sets its own conditions before they're transformed, which
+// replaces a persisted condition whose message the transformer rewrote, and
+// resets its last transition time. We therefore prefer the last transition
+// time of the supplied persisted conditions over that of the existing ones.
+func transformConditions(mg resource.Managed, fn ConditionTransformer, persisted []xpv1.Condition, now metav1.Time) error {
+	existing, err := conditionsOf(mg)
+	if err != nil {
+		return err
+	}
+
+	// Pass the transformer a copy, so it can't modify existing conditions.
+	out := fn(mg, append([]xpv1.Condition(nil), existing...))
+	for i := range out {
+		if prev, found := findCondition(persisted, out[i].Type); found && prev.Status == out[i].Status {
+			out[i].LastTransitionTime = prev.LastTransitionTime
+			continue
+		}
+		prev, found := findCondition(existing, out[i].Type)
+		switch {
+		case found && prev.Status == out[i].Status:
+			out[i].LastTransitionTime = prev.LastTransitionTime
+		case out[i].LastTransitionTime.IsZero():
+			out[i].LastTransitionTime = now
+		}
+	}
+	mg.SetConditions(out...)
+	return nil
+}
+
+// A conditionsGetter returns all of its conditions.
+type conditionsGetter interface {
+	GetConditions() []xpv1.Condition
+}
+
+// conditionsOf returns all of the conditions of the supplied managed resource.
+func conditionsOf(mg resource.Managed) ([]xpv1.Condition, error) {
+	if cg, ok := mg.(conditionsGetter); ok {
+		return cg.GetConditions(), nil
+	}
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetStatusConditions)
+	}
+	cs := []xpv1.Condition{}
+	if err := p.GetValueInto("status.conditions", &cs); err != nil && !fieldpath.IsNotFound(err) {
+		return nil, errors.Wrap(err, errGetStatusConditions)
+	}
+	return cs, nil
+}
+
+func findCondition(cs []xpv1.Condition, ct xpv1.ConditionType) (xpv1.Condition, bool) {
+	for _, c := range cs {
+		if c.Type == ct {
+			return c, true
+		}
+	}
+	return xpv1.Condition{}, false
+}
+
+// TypeReferencesResolved managed resources have resolved all of their
+// references to other resources. The Reconciler only sets this condition if
+// it's configured to record reference resolution events.
+const TypeReferencesResolved xpv1.ConditionType = "ReferencesResolved"
+
+// Reasons a managed resource's references are or are not resolved.
+const (
+	ReasonReferencesResolved   xpv1.ConditionReason = "ResolvedReferences"
+	ReasonReferencesUnresolved xpv1.ConditionReason = "CannotResolveReferences"
+)
+
+// ReferencesResolved returns a condition that indicates a managed resource has
+// resolved all of its references.
+func ReferencesResolved() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReferencesResolved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReferencesResolved,
+	}
+}
+
+// ReferencesUnresolved returns a condition that indicates a managed resource
+// couldn't resolve its references, and why.
+func ReferencesUnresolved(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReferencesResolved,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReferencesUnresolved,
+		Message:            err.Error(),
+	}
+}
+
+// referencesWereUnresolved returns true if the supplied managed resource
+// previously failed to resolve its references.
+func referencesWereUnresolved(mg resource.Managed) bool {
+	return mg.GetCondition(TypeReferencesResolved).Status == corev1.ConditionFalse
+}
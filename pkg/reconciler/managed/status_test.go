@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestUpdateStatusAndReturn(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		c      client.Client
+		result reconcile.Result
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "The supplied result should be returned if the status update succeeds.",
+			args: args{
+				c:      &test.MockClient{MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil)},
+				result: reconcile.Result{RequeueAfter: time.Minute},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+			},
+		},
+		"UpdateError": {
+			reason: "The supplied result should be returned along with any wrapped status update error.",
+			args: args{
+				c:      &test.MockClient{MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom)},
+				result: reconcile.Result{Requeue: true},
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				err:    errors.Wrap(errBoom, errUpdateManagedStatus),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := updateStatusAndReturn(context.Background(), tc.args.c.Status(), &fake.Managed{}, tc.args.result)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nupdateStatusAndReturn(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\n%s\nupdateStatusAndReturn(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTransformConditions(t *testing.T) {
+	then := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	custom := xpv1.ConditionType("Custom")
+
+	type args struct {
+		persisted []xpv1.Condition
+		existing  []xpv1.Condition
+		fn        ConditionTransformer
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []xpv1.Condition
+	}{
+		"MessageRewritten": {
+			reason: "Rewriting the message of a condition should preserve its last transition time, since its status didn't change.",
+			args: args{
+				existing: []xpv1.Condition{{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse, Reason: xpv1.ReasonReconcileError, Message: "secret", LastTransitionTime: then}},
+				fn: func(_ resource.Managed, c []xpv1.Condition) []xpv1.Condition {
+					return []xpv1.Condition{c[0].WithMessage("masked")}
+				},
+			},
+			want: []xpv1.Condition{{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse, Reason: xpv1.ReasonReconcileError, Message: "masked", LastTransitionTime: then}},
+		},
+		"PersistedTransitionTime": {
+			reason: "Rewriting the message of a condition the Reconciler replaced should preserve its persisted last transition time.",
+			args: args{
+				persisted: []xpv1.Condition{{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse, Reason: xpv1.ReasonReconcileError, Message: "masked", LastTransitionTime: then}},
+				existing:  []xpv1.Condition{{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse, Reason: xpv1.ReasonReconcileError, Message: "secret", LastTransitionTime: metav1.Now()}},
+				fn: func(_ resource.Managed, c []xpv1.Condition) []xpv1.Condition {
+					return []xpv1.Condition{c[0].WithMessage("masked")}
+				},
+			},
+			want: []xpv1.Condition{{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse, Reason: xpv1.ReasonReconcileError, Message: "masked", LastTransitionTime: then}},
+		},
+		"StaleTransitionTime": {
+			reason: "A condition whose status changed shouldn't keep the last transition time of the condition it replaces.",
+			args: args{
+				existing: []xpv1.Condition{{Type: custom, Status: corev1.ConditionFalse, LastTransitionTime: then}},
+				fn: func(_ resource.Managed, _ []xpv1.Condition) []xpv1.Condition {
+					return []xpv1.Condition{{Type: custom, Status: corev1.ConditionTrue}}
+				},
+			},
+			want: []xpv1.Condition{{Type: custom, Status: corev1.ConditionTrue}},
+		},
+		"ConditionAdded": {
+			reason: "An added condition without a last transition time should get one, and existing conditions should be left unchanged.",
+			args: args{
+				existing: []xpv1.Condition{{Type: xpv1.TypeSynced, Status: corev1.ConditionTrue, LastTransitionTime: then}},
+				fn: func(_ resource.Managed, _ []xpv1.Condition) []xpv1.Condition {
+					return []xpv1.Condition{{Type: custom, Status: corev1.ConditionTrue}}
+				},
+			},
+			want: []xpv1.Condition{
+				{Type: xpv1.TypeSynced, Status: corev1.ConditionTrue, LastTransitionTime: then},
+				{Type: custom, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{ConditionedStatus: xpv1.ConditionedStatus{Conditions: tc.args.existing}}
+			if err := transformConditions(mg, tc.args.fn, tc.args.persisted, metav1.Now()); err != nil {
+				t.Fatalf("\n%s\ntransformConditions(...): unexpected error: %s", tc.reason, err)
+			}
+			got := mg.Conditions
+			for i := range got {
+				if got[i].LastTransitionTime.IsZero() {
+					t.Errorf("\n%s\ntransformConditions(...): condition %q has no last transition time", tc.reason, got[i].Type)
+				}
+				// The current time isn't deterministic, so we only compare
+				// last transition times we set ourselves.
+				if !got[i].LastTransitionTime.Equal(&then) {
+					got[i].LastTransitionTime = metav1.Time{}
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ntransformConditions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+)
+
+const reasonInSync event.Reason = "InSync"
+
+// WithPeriodicSyncEvent configures the Reconciler to emit a Normal InSync
+// event when it finds that an external resource is up to date, at most once
+// per supplied interval per managed resource. When each managed resource last
+// emitted an InSync event is tracked in memory, so a managed resource may emit
+// an InSync event sooner than the supplied interval after the controller
+// restarts. By default no InSync events are emitted.
+func WithPeriodicSyncEvent(interval time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.syncEvents = newSyncEventTracker(interval)
+	}
+}
+
+// A syncEventTracker tracks when each managed resource last emitted an InSync
+// event.
+type syncEventTracker struct {
+	interval time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	last  map[types.UID]time.Time
+	swept time.Time
+}
+
+func newSyncEventTracker(interval time.Duration) *syncEventTracker {
+	return &syncEventTracker{interval: interval, now: time.Now, last: map[types.UID]time.Time{}}
+}
+
+// Due returns true if the managed resource with the supplied UID is due to
+// emit an InSync event, and if so records that it emitted one.
+func (t *syncEventTracker) Due(uid types.UID) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	if last, ok := t.last[uid]; ok && now.Sub(last) < t.interval {
+		return false
+	}
+
+	// Forget managed resources that are due, so that we don't remember
+	// managed resources that have been deleted forever. We sweep at most
+	// once per interval so that the cost of sweeping is amortized across
+	// all the managed resources we track, rather than paid on every call.
+	if now.Sub(t.swept) >= t.interval {
+		for k, v := range t.last {
+			if now.Sub(v) >= t.interval {
+				delete(t.last, k)
+			}
+		}
+		t.swept = now
+	}
+	t.last[uid] = now
+	return true
+}
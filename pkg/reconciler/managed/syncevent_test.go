@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestReconcilerPeriodicSyncEvent(t *testing.T) {
+	interval := 1 * time.Hour
+
+	type args struct {
+		o []ReconcilerOption
+
+		// elapsed is the time elapsed at each reconcile.
+		elapsed []time.Duration
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []event.Reason
+	}{
+		"Disabled": {
+			reason: "No InSync events should be emitted by default.",
+			args:   args{elapsed: []time.Duration{0, interval}},
+		},
+		"WithinInterval": {
+			reason: "An InSync event shouldn't be emitted again within the interval.",
+			args: args{
+				o:       []ReconcilerOption{WithPeriodicSyncEvent(interval)},
+				elapsed: []time.Duration{0, interval / 2},
+			},
+			want: []event.Reason{reasonInSync},
+		},
+		"AfterInterval": {
+			reason: "An InSync event should be emitted again once the interval has passed.",
+			args: args{
+				o:       []ReconcilerOption{WithPeriodicSyncEvent(interval)},
+				elapsed: []time.Duration{0, interval / 2, interval},
+			},
+			want: []event.Reason{reasonInSync, reasonInSync},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := &reasonRecorder{}
+			c := &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			o := append([]ReconcilerOption{
+				WithRecorder(rec),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}, tc.args.o...)
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})), o...)
+
+			start := time.Now()
+			for _, e := range tc.args.elapsed {
+				if r.syncEvents != nil {
+					r.syncEvents.now = func() time.Time { return start.Add(e) }
+				}
+				if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+					t.Fatalf("\nReason: %s\nr.Reconcile(...): unexpected error: %s", tc.reason, err)
+				}
+			}
+			if diff := cmp.Diff(tc.want, rec.reasons, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSyncEventTrackerSweep(t *testing.T) {
+	interval := 1 * time.Hour
+	start := time.Now()
+
+	st := newSyncEventTracker(interval)
+	at := func(d time.Duration) func() time.Time { return func() time.Time { return start.Add(d) } }
+
+	sorted := cmpopts.SortSlices(func(a, b types.UID) bool { return a < b })
+
+	// The first call sweeps.
+	st.now = at(0)
+	st.Due(types.UID("cool"))
+	st.now = at(interval / 2)
+	st.Due(types.UID("lame"))
+
+	// A call an interval after the last sweep should sweep, forgetting
+	// managed resources that are due.
+	st.now = at(interval)
+	st.Due(types.UID("other"))
+	want := []types.UID{"lame", "other"}
+	if diff := cmp.Diff(want, keys(st.last), sorted); diff != "" {
+		t.Errorf("\nReason: Calls an interval after the last sweep should forget managed resources that are due.\nst.Due(...): -want, +got:\n%s", diff)
+	}
+
+	// A call within an interval of the last sweep shouldn't sweep, even
+	// though lame is now due.
+	st.now = at(interval + interval*3/4)
+	st.Due(types.UID("new"))
+	want = []types.UID{"lame", "other", "new"}
+	if diff := cmp.Diff(want, keys(st.last), sorted); diff != "" {
+		t.Errorf("\nReason: Calls within an interval of the last sweep shouldn't forget managed resources.\nst.Due(...): -want, +got:\n%s", diff)
+	}
+}
+
+func keys(m map[types.UID]time.Time) []types.UID {
+	out := make([]types.UID, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
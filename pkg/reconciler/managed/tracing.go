@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// The name of the tracer used by the Reconciler.
+const tracerName = "github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+// Names of the spans created by the Reconciler.
+const (
+	SpanConnect           = "Connect"
+	SpanObserve           = "Observe"
+	SpanCreate            = "Create"
+	SpanUpdate            = "Update"
+	SpanDelete            = "Delete"
+	SpanPublishConnection = "PublishConnection"
+)
+
+// Attributes added to the spans created by the Reconciler.
+const (
+	AttributeKeyGVK          = attribute.Key("crossplane.io/gvk")
+	AttributeKeyExternalName = attribute.Key("crossplane.io/external-name")
+)
+
+// WithTracer configures the Reconciler to create a span around each call to
+// connect to, observe, create, update, or delete an external resource, and
+// around each attempt to publish connection details. Spans are created using
+// a tracer from the supplied provider. By default no spans are created.
+func WithTracer(tp trace.TracerProvider) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.tracer = tp.Tracer(tracerName)
+	}
+}
+
+func defaultTracer() trace.Tracer {
+	return noop.NewTracerProvider().Tracer(tracerName)
+}
+
+// startSpan starts a span with the supplied name. Attributes are only computed
+// if the span is recording, so the default no-op tracer adds no overhead.
+func (r *Reconciler) startSpan(ctx context.Context, name string, mg resource.Managed) (context.Context, trace.Span) {
+	ctx, span := r.tracer.Start(ctx, name)
+	if span.IsRecording() {
+		span.SetAttributes(
+			AttributeKeyGVK.String(r.gvk.String()),
+			AttributeKeyExternalName.String(resource.GetExternalName(mg)),
+		)
+	}
+	return ctx, span
+}
+
+// endSpan ends the supplied span, recording the supplied error, if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// connect to the external system, tracing the connection and all subsequent
+// calls to the returned ExternalClient. The result of each call to the
+// returned ExternalClient is also reported to the ConcurrencyController. The
+// ExternalClient is wrapped by any ExternalClientMiddleware.
+func (r *Reconciler) connect(ctx context.Context, mg resource.Managed) (ExternalClient, error) {
+	sctx, span := r.startSpan(ctx, SpanConnect, mg)
+	ec, err := r.external.Connect(sctx, mg)
+	endSpan(span, err)
+	if err != nil {
+		return nil, err
+	}
+	ec = r.wrapExternalClient(ec)
+	return &tracedExternalClient{ExternalClient: &concurrencyObservingExternalClient{ExternalClient: ec, cc: r.concurrency}, r: r}, nil
+}
+
+// publishConnection publishes the supplied connection details, tracing the
+// attempt.
+func (r *Reconciler) publishConnection(ctx context.Context, mg resource.Managed, c ConnectionDetails) (bool, error) {
+	sctx, span := r.startSpan(ctx, SpanPublishConnection, mg)
+	published, err := r.managed.PublishConnection(sctx, mg, c)
+	endSpan(span, err)
+	return published, err
+}
+
+// A tracedExternalClient creates a span around each call to an ExternalClient.
+type tracedExternalClient struct {
+	ExternalClient
+
+	r *Reconciler
+}
+
+func (c *tracedExternalClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	ctx, span := c.r.startSpan(ctx, SpanObserve, mg)
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	endSpan(span, err)
+	return o, err
+}
+
+func (c *tracedExternalClient) Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error) {
+	ctx, span := c.r.startSpan(ctx, SpanCreate, mg)
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	endSpan(span, err)
+	return cr, err
+}
+
+func (c *tracedExternalClient) Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error) {
+	ctx, span := c.r.startSpan(ctx, SpanUpdate, mg)
+	u, err := c.ExternalClient.Update(ctx, mg)
+	endSpan(span, err)
+	return u, err
+}
+
+func (c *tracedExternalClient) Delete(ctx context.Context, mg resource.Managed) (ExternalDelete, error) {
+	ctx, span := c.r.startSpan(ctx, SpanDelete, mg)
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	endSpan(span, err)
+	return d, err
+}
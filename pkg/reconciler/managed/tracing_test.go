@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestReconcilerTracing(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type span struct {
+		Name       string
+		Status     codes.Code
+		Attributes []attribute.KeyValue
+	}
+
+	attrs := []attribute.KeyValue{
+		AttributeKeyGVK.String(fake.GVK(&fake.Managed{}).String()),
+		AttributeKeyExternalName.String("cool-external"),
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      ExternalClient
+		want   []span
+	}{
+		"UpToDate": {
+			reason: "We should create a span for each phase of a successful reconcile.",
+			c: &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			},
+			want: []span{
+				{Name: SpanConnect, Attributes: attrs},
+				{Name: SpanObserve, Attributes: attrs},
+				{Name: SpanPublishConnection, Attributes: attrs},
+			},
+		},
+		"ObserveError": {
+			reason: "We should record errors as the status of a span.",
+			c: &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{}, errBoom
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			},
+			want: []span{
+				{Name: SpanConnect, Attributes: attrs},
+				{Name: SpanObserve, Status: codes.Error, Attributes: attrs},
+			},
+		},
+		"CreateError": {
+			reason: "We should create a span for a failed create.",
+			c: &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: false}, nil
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+					return ExternalCreation{}, errBoom
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			},
+			want: []span{
+				{Name: SpanConnect, Attributes: attrs},
+				{Name: SpanObserve, Attributes: attrs},
+				{Name: SpanPublishConnection, Attributes: attrs},
+				{Name: SpanCreate, Status: codes.Error, Attributes: attrs},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					meta.SetExternalName(obj, "cool-external")
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			r := NewReconciler(&fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.Managed{})}, resource.ManagedKind(fake.GVK(&fake.Managed{})),
+				WithTracer(tp),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnecter(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) { return tc.c, nil })),
+				WithConnectionPublishers(),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			)
+			_, _ = r.Reconcile(context.Background(), reconcile.Request{})
+
+			got := make([]span, 0, len(sr.Ended()))
+			for _, s := range sr.Ended() {
+				got = append(got, span{Name: s.Name(), Status: s.Status().Code, Attributes: s.Attributes()})
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.Comparer(func(a, b attribute.Value) bool { return a.Emit() == b.Emit() })); diff != "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): -want spans, +got spans:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
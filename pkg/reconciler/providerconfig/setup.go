@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errGetConfigKind        = "cannot determine ProviderConfig kind"
+	errGetUsageKind         = "cannot determine ProviderConfigUsage kind"
+	errFmtUsageListNotKnown = "ProviderConfigUsage list kind %s is not registered with the scheme"
+	errSetupProviderConfig  = "cannot setup ProviderConfig controller"
+)
+
+// A ConfigPointer is a pointer to a ProviderConfig of type T.
+type ConfigPointer[T any] interface {
+	*T
+	resource.ProviderConfig
+}
+
+// A UsagePointer is a pointer to a ProviderConfigUsage of type T.
+type UsagePointer[T any] interface {
+	*T
+	resource.ProviderConfigUsage
+}
+
+// Setup adds a controller that reconciles ProviderConfigs of type PC to the
+// supplied manager. The controller watches ProviderConfigUsages of type PCU,
+// and blocks deletion of a ProviderConfig while any usages of it exist. Both
+// types, and the list type of PCU, must be registered with the manager's
+// scheme. For example:
+//
+//	providerconfig.Setup[v1beta1.ProviderConfig, v1beta1.ProviderConfigUsage](mgr)
+func Setup[PC, PCU any, PCPtr ConfigPointer[PC], PCUPtr UsagePointer[PCU]](mgr manager.Manager, o ...ReconcilerOption) error {
+	pc, pcu := PCPtr(new(PC)), PCUPtr(new(PCU))
+	of, err := kindsOf(mgr.GetScheme(), pc, pcu)
+	if err != nil {
+		return errors.Wrap(err, errSetupProviderConfig)
+	}
+
+	return errors.Wrap(builder.ControllerManagedBy(mgr).
+		Named(ControllerName(of.Config.Kind)).
+		For(pc).
+		Watches(pcu, &resource.EnqueueRequestForProviderConfig{}).
+		Complete(NewReconciler(mgr, of, o...)), errSetupProviderConfig)
+}
+
+// kindsOf returns the kinds of the supplied ProviderConfig and
+// ProviderConfigUsage, and of the ProviderConfigUsage's list type.
+func kindsOf(s *runtime.Scheme, pc resource.ProviderConfig, pcu resource.ProviderConfigUsage) (resource.ProviderConfigKinds, error) {
+	cgvk, err := apiutil.GVKForObject(pc, s)
+	if err != nil {
+		return resource.ProviderConfigKinds{}, errors.Wrap(err, errGetConfigKind)
+	}
+	ugvk, err := apiutil.GVKForObject(pcu, s)
+	if err != nil {
+		return resource.ProviderConfigKinds{}, errors.Wrap(err, errGetUsageKind)
+	}
+	lgvk := ugvk.GroupVersion().WithKind(ugvk.Kind + "List")
+	if !s.Recognizes(lgvk) {
+		return resource.ProviderConfigKinds{}, errors.Errorf(errFmtUsageListNotKnown, lgvk)
+	}
+	return resource.ProviderConfigKinds{Config: cgvk, Usage: ugvk, UsageList: lgvk}, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// Setup should be instantiable with only the ProviderConfig and
+// ProviderConfigUsage types; their pointer types should be inferred.
+var _ = Setup[fake.ProviderConfig, fake.ProviderConfigUsage]
+
+func TestKindsOf(t *testing.T) {
+	unregistered := fake.SchemeWith(&fake.ProviderConfigUsage{}, &ProviderConfigUsageList{})
+	_, errNotRegistered := apiutil.GVKForObject(&fake.ProviderConfig{}, unregistered)
+
+	type want struct {
+		of  resource.ProviderConfigKinds
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		s      *runtime.Scheme
+		want   want
+	}{
+		"Success": {
+			reason: "We should return the kinds of the ProviderConfig, its usage, and its usage list.",
+			s:      fake.SchemeWith(&fake.ProviderConfig{}, &fake.ProviderConfigUsage{}, &ProviderConfigUsageList{}),
+			want: want{
+				of: resource.ProviderConfigKinds{
+					Config:    fake.GVK(&fake.ProviderConfig{}),
+					Usage:     fake.GVK(&fake.ProviderConfigUsage{}),
+					UsageList: fake.GVK(&ProviderConfigUsageList{}),
+				},
+			},
+		},
+		"ConfigNotRegistered": {
+			reason: "We should return an error if the ProviderConfig isn't registered with the scheme.",
+			s:      unregistered,
+			want: want{
+				err: errors.Wrap(errNotRegistered, errGetConfigKind),
+			},
+		},
+		"UsageListNotRegistered": {
+			reason: "We should return an error if the ProviderConfigUsage list isn't registered with the scheme.",
+			s:      fake.SchemeWith(&fake.ProviderConfig{}, &fake.ProviderConfigUsage{}),
+			want: want{
+				err: errors.Errorf(errFmtUsageListNotKnown, fake.GVK(&ProviderConfigUsageList{})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			of, err := kindsOf(tc.s, &fake.ProviderConfig{}, &fake.ProviderConfigUsage{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nkindsOf(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.of, of); diff != "" {
+				t.Errorf("\n%s\nkindsOf(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
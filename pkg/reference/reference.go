@@ -49,11 +49,11 @@ type To struct {
 // resource.
 type ExtractValueFn func(resource.Managed) string
 
-// ExternalName extracts the resolved managed resource's external name from its
-// external name annotation.
+// ExternalName extracts the resolved managed resource's external name, which is
+// usually stored in its external name annotation.
 func ExternalName() ExtractValueFn {
 	return func(mg resource.Managed) string {
-		return meta.GetExternalName(mg)
+		return resource.GetExternalName(mg)
 	}
 }
 
@@ -155,7 +155,11 @@ func (rr *MultiResolutionRequest) IsNoOp() bool {
 
 // A MultiResolutionResponse returns the result of several reference
 // resolutions. The returned values are always safe to set if resolution was
-// successful.
+// successful. No value is resolved for an optional reference to a resource
+// that doesn't exist, but the reference is returned so that it may be resolved
+// if the resource is created later. ResolvedValues may therefore be shorter
+// than ResolvedReferences, in which case its values don't correspond to the
+// references at the same index.
 type MultiResolutionResponse struct {
 	ResolvedValues     []string
 	ResolvedReferences []xpv1.Reference
@@ -201,6 +205,13 @@ func (r *APIResolver) Resolve(ctx context.Context, req ResolutionRequest) (Resol
 	// The reference is already set - resolve it.
 	if req.Reference != nil {
 		if err := r.client.Get(ctx, types.NamespacedName{Name: req.Reference.Name}, req.To.Managed); err != nil {
+			if kerrors.IsNotFound(err) && req.Reference.Policy.IsResolutionPolicyOptional() {
+				// An optional reference to a resource that doesn't exist
+				// leaves the field's current value unchanged, and keeps the
+				// reference so that we may resolve it if the resource is
+				// created later.
+				return ResolutionResponse{ResolvedValue: req.CurrentValue, ResolvedReference: req.Reference}, nil
+			}
 			if kerrors.IsNotFound(err) {
 				return ResolutionResponse{}, getResolutionError(req.Reference.Policy, errors.Wrap(err, errGetManaged))
 			}
@@ -240,19 +251,35 @@ func (r *APIResolver) ResolveMultiple(ctx context.Context, req MultiResolutionRe
 
 	// The references are already set - resolve them.
 	if len(req.References) > 0 {
-		vals := make([]string, len(req.References))
-		for i := range req.References {
-			if err := r.client.Get(ctx, types.NamespacedName{Name: req.References[i].Name}, req.To.Managed); err != nil {
+		vals := make([]string, 0, len(req.References))
+		resolved := make([]xpv1.Reference, 0, len(req.References))
+		for _, ref := range req.References {
+			if err := r.client.Get(ctx, types.NamespacedName{Name: ref.Name}, req.To.Managed); err != nil {
+				if kerrors.IsNotFound(err) && ref.Policy.IsResolutionPolicyOptional() {
+					// An optional reference to a resource that doesn't
+					// exist is skipped, but kept so that we may resolve it
+					// if the resource is created later.
+					continue
+				}
 				if kerrors.IsNotFound(err) {
-					return MultiResolutionResponse{}, getResolutionError(req.References[i].Policy, errors.Wrap(err, errGetManaged))
+					return MultiResolutionResponse{}, getResolutionError(ref.Policy, errors.Wrap(err, errGetManaged))
 				}
 				return MultiResolutionResponse{}, errors.Wrap(err, errGetManaged)
 			}
-			vals[i] = req.Extract(req.To.Managed)
+			vals = append(vals, req.Extract(req.To.Managed))
+			resolved = append(resolved, ref)
 		}
 
 		rsp := MultiResolutionResponse{ResolvedValues: vals, ResolvedReferences: req.References}
-		return rsp, rsp.Validate()
+
+		// Every reference was optional, and to a resource that doesn't exist.
+		if len(resolved) == 0 {
+			return rsp, nil
+		}
+
+		// Validate the resolved values against the references they were
+		// resolved from, which are index-aligned.
+		return rsp, MultiResolutionResponse{ResolvedValues: vals, ResolvedReferences: resolved}.Validate()
 	}
 
 	// No references were set, but a selector was. Select and resolve references.
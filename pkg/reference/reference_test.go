@@ -22,7 +22,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -152,6 +154,42 @@ func TestResolve(t *testing.T) {
 				err: errors.Wrap(errBoom, errGetManaged),
 			},
 		},
+		"RequiredReferenceNotFound": {
+			reason: "Should return an error if a required reference is to a resource that does not exist",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "cool")),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Reference: ref,
+					To:        To{Managed: &fake.Managed{}},
+					Extract:   ExternalName(),
+				},
+			},
+			want: want{
+				err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{}, "cool"), errGetManaged),
+			},
+		},
+		"OptionalReferenceNotFound": {
+			reason: "Should leave the current value unchanged and keep the reference if an optional reference is to a resource that does not exist",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "cool")),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: ResolutionRequest{
+					Reference: optionalRef,
+					To:        To{Managed: &fake.Managed{}},
+					Extract:   ExternalName(),
+				},
+			},
+			want: want{
+				rsp: ResolutionResponse{
+					ResolvedReference: optionalRef,
+				},
+			},
+		},
 		"ResolvedNoValue": {
 			reason: "Should return an error if the extract function returns the empty string",
 			c: &test.MockClient{
@@ -533,6 +571,94 @@ func TestResolveMultiple(t *testing.T) {
 				err: nil,
 			},
 		},
+		"OptionalReferenceNotFound": {
+			reason: "Should skip the value of, but keep, an optional reference to a resource that does not exist",
+			c: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if key.Name == "missing" {
+						return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+					}
+					meta.SetExternalName(obj.(metav1.Object), value)
+					return nil
+				},
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: MultiResolutionRequest{
+					References: []xpv1.Reference{ref, {Name: "missing", Policy: &xpv1.Policy{Resolution: &optionalPolicy}}},
+					To:         To{Managed: &fake.Managed{}},
+					Extract:    ExternalName(),
+				},
+			},
+			want: want{
+				rsp: MultiResolutionResponse{
+					ResolvedValues:     []string{value},
+					ResolvedReferences: []xpv1.Reference{ref, {Name: "missing", Policy: &xpv1.Policy{Resolution: &optionalPolicy}}},
+				},
+			},
+		},
+		"OptionalReferenceNotFoundBeforeEmptyValue": {
+			reason: "Should validate resolved values against the references they were resolved from, not those at the same index",
+			c: &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, _ client.Object) error {
+					if key.Name == "missing" {
+						return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+					}
+					return nil
+				},
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: MultiResolutionRequest{
+					References: []xpv1.Reference{{Name: "missing", Policy: &xpv1.Policy{Resolution: &optionalPolicy}}, ref},
+					To:         To{Managed: &fake.Managed{}},
+					Extract:    ExternalName(),
+				},
+			},
+			want: want{
+				rsp: MultiResolutionResponse{
+					ResolvedValues:     []string{""},
+					ResolvedReferences: []xpv1.Reference{{Name: "missing", Policy: &xpv1.Policy{Resolution: &optionalPolicy}}, ref},
+				},
+				err: errors.New(errNoValue),
+			},
+		},
+		"AllOptionalReferencesNotFound": {
+			reason: "Should keep optional references to resources that do not exist, without resolving any values",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "cool")),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: MultiResolutionRequest{
+					References: []xpv1.Reference{optionalRef},
+					To:         To{Managed: &fake.Managed{}},
+					Extract:    ExternalName(),
+				},
+			},
+			want: want{
+				rsp: MultiResolutionResponse{
+					ResolvedReferences: []xpv1.Reference{optionalRef},
+				},
+			},
+		},
+		"RequiredReferenceNotFound": {
+			reason: "Should return an error if a required reference is to a resource that does not exist",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "cool")),
+			},
+			from: &fake.Managed{},
+			args: args{
+				req: MultiResolutionRequest{
+					References: []xpv1.Reference{ref},
+					To:         To{Managed: &fake.Managed{}},
+					Extract:    ExternalName(),
+				},
+			},
+			want: want{
+				err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{}, "cool"), errGetManaged),
+			},
+		},
 		"ListError": {
 			reason: "Should return errors encountered while listing potential referenced resources",
 			c: &test.MockClient{
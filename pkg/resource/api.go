@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -35,16 +36,64 @@ const (
 	errUpdateObject = "cannot update object"
 )
 
+// An APIApplicatorOption configures an APIPatchingApplicator or an
+// APIUpdatingApplicator.
+type APIApplicatorOption func(*apiApplicatorConfig)
+
+type apiApplicatorConfig struct {
+	dryRun bool
+}
+
+// WithDryRun configures an applicator to pass client.DryRunAll to the API
+// server when it creates, patches, or updates an object. The applied object is
+// updated with the API server's projection of the result, but no changes are
+// persisted. This is useful for tooling that previews what would be applied.
+func WithDryRun() APIApplicatorOption {
+	return func(c *apiApplicatorConfig) {
+		c.dryRun = true
+	}
+}
+
+func newAPIApplicatorConfig(o ...APIApplicatorOption) apiApplicatorConfig {
+	c := apiApplicatorConfig{}
+	for _, fn := range o {
+		fn(&c)
+	}
+	return c
+}
+
+func (c apiApplicatorConfig) createOptions() []client.CreateOption {
+	if c.dryRun {
+		return []client.CreateOption{client.DryRunAll}
+	}
+	return nil
+}
+
+func (c apiApplicatorConfig) patchOptions() []client.PatchOption {
+	if c.dryRun {
+		return []client.PatchOption{client.DryRunAll}
+	}
+	return nil
+}
+
+func (c apiApplicatorConfig) updateOptions() []client.UpdateOption {
+	if c.dryRun {
+		return []client.UpdateOption{client.DryRunAll}
+	}
+	return nil
+}
+
 // An APIPatchingApplicator applies changes to an object by either creating or
 // patching it in a Kubernetes API server.
 type APIPatchingApplicator struct {
 	client client.Client
+	config apiApplicatorConfig
 }
 
 // NewAPIPatchingApplicator returns an Applicator that applies changes to an
 // object by either creating or patching it in a Kubernetes API server.
-func NewAPIPatchingApplicator(c client.Client) *APIPatchingApplicator {
-	return &APIPatchingApplicator{client: c}
+func NewAPIPatchingApplicator(c client.Client, o ...APIApplicatorOption) *APIPatchingApplicator {
+	return &APIPatchingApplicator{client: c, config: newAPIApplicatorConfig(o...)}
 }
 
 // Apply changes to the supplied object. The object will be created if it does
@@ -57,7 +106,7 @@ func (a *APIPatchingApplicator) Apply(ctx context.Context, o client.Object, ao .
 	}
 
 	if m.GetName() == "" && m.GetGenerateName() != "" {
-		return errors.Wrap(a.client.Create(ctx, o), "cannot create object")
+		return errors.Wrap(a.client.Create(ctx, o, a.config.createOptions()...), "cannot create object")
 	}
 
 	desired := o.DeepCopyObject()
@@ -65,7 +114,7 @@ func (a *APIPatchingApplicator) Apply(ctx context.Context, o client.Object, ao .
 	err := a.client.Get(ctx, types.NamespacedName{Name: m.GetName(), Namespace: m.GetNamespace()}, o)
 	if kerrors.IsNotFound(err) {
 		// TODO(negz): Apply ApplyOptions here too?
-		return errors.Wrap(a.client.Create(ctx, o), "cannot create object")
+		return errors.Wrap(a.client.Create(ctx, o, a.config.createOptions()...), "cannot create object")
 	}
 	if err != nil {
 		return errors.Wrap(err, "cannot get object")
@@ -78,7 +127,7 @@ func (a *APIPatchingApplicator) Apply(ctx context.Context, o client.Object, ao .
 	}
 
 	// TODO(negz): Allow callers to override the kind of patch used.
-	return errors.Wrap(a.client.Patch(ctx, o, &patch{desired}), "cannot patch object")
+	return errors.Wrap(a.client.Patch(ctx, o, &patch{desired}, a.config.patchOptions()...), "cannot patch object")
 }
 
 type patch struct{ from runtime.Object }
@@ -90,12 +139,13 @@ func (p *patch) Data(_ client.Object) ([]byte, error) { return json.Marshal(p.fr
 // updating it in a Kubernetes API server.
 type APIUpdatingApplicator struct {
 	client client.Client
+	config apiApplicatorConfig
 }
 
 // NewAPIUpdatingApplicator returns an Applicator that applies changes to an
 // object by either creating or updating it in a Kubernetes API server.
-func NewAPIUpdatingApplicator(c client.Client) *APIUpdatingApplicator {
-	return &APIUpdatingApplicator{client: c}
+func NewAPIUpdatingApplicator(c client.Client, o ...APIApplicatorOption) *APIUpdatingApplicator {
+	return &APIUpdatingApplicator{client: c, config: newAPIApplicatorConfig(o...)}
 }
 
 // Apply changes to the supplied object. The object will be created if it does
@@ -107,7 +157,7 @@ func (a *APIUpdatingApplicator) Apply(ctx context.Context, o client.Object, ao .
 	}
 
 	if m.GetName() == "" && m.GetGenerateName() != "" {
-		return errors.Wrap(a.client.Create(ctx, o), "cannot create object")
+		return errors.Wrap(a.client.Create(ctx, o, a.config.createOptions()...), "cannot create object")
 	}
 
 	//nolint:forcetypeassert // Will always be a client.Object.
@@ -116,7 +166,7 @@ func (a *APIUpdatingApplicator) Apply(ctx context.Context, o client.Object, ao .
 	err := a.client.Get(ctx, types.NamespacedName{Name: m.GetName(), Namespace: m.GetNamespace()}, current)
 	if kerrors.IsNotFound(err) {
 		// TODO(negz): Apply ApplyOptions here too?
-		return errors.Wrap(a.client.Create(ctx, m), "cannot create object")
+		return errors.Wrap(a.client.Create(ctx, m, a.config.createOptions()...), "cannot create object")
 	}
 	if err != nil {
 		return errors.Wrap(err, "cannot get object")
@@ -131,7 +181,7 @@ func (a *APIUpdatingApplicator) Apply(ctx context.Context, o client.Object, ao .
 	// NOTE(hasheddan): we must set the resource version of the desired object
 	// to that of the current or the update will always fail.
 	m.SetResourceVersion(current.GetResourceVersion())
-	return errors.Wrap(a.client.Update(ctx, m), "cannot update object")
+	return errors.Wrap(a.client.Update(ctx, m, a.config.updateOptions()...), "cannot update object")
 }
 
 // An APIFinalizer adds and removes finalizers to and from a resource.
@@ -167,13 +217,54 @@ func (a *APIFinalizer) AddFinalizer(ctx context.Context, obj Object) error {
 	return errors.Wrap(a.client.Update(ctx, obj), errUpdateObject)
 }
 
-// RemoveFinalizer from the supplied Managed resource.
+// RemoveFinalizer from the supplied Managed resource. Other controllers may
+// concurrently add or remove their own finalizers, so if the update conflicts
+// the resource is fetched again and its finalizers recomputed. A finalizer
+// that has already been removed is treated as success.
 func (a *APIFinalizer) RemoveFinalizer(ctx context.Context, obj Object) error {
 	if !meta.FinalizerExists(obj, a.finalizer) {
 		return nil
 	}
-	meta.RemoveFinalizer(obj, a.finalizer)
-	return errors.Wrap(IgnoreNotFound(a.client.Update(ctx, obj)), errUpdateObject)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		meta.RemoveFinalizer(obj, a.finalizer)
+		err := a.client.Update(ctx, obj)
+		if !kerrors.IsConflict(err) {
+			return err
+		}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj); err != nil {
+			return err
+		}
+		if !meta.FinalizerExists(obj, a.finalizer) {
+			return nil
+		}
+		return err
+	})
+	return errors.Wrap(IgnoreNotFound(err), errUpdateObject)
+}
+
+// A MultiFinalizer chains multiple Finalizers.
+type MultiFinalizer []Finalizer
+
+// AddFinalizer calls each Finalizer's AddFinalizer method serially. It calls
+// every Finalizer even if some fail, and returns all errors it encounters,
+// joined.
+func (m MultiFinalizer) AddFinalizer(ctx context.Context, obj Object) error {
+	errs := make([]error, 0, len(m))
+	for _, f := range m {
+		errs = append(errs, f.AddFinalizer(ctx, obj))
+	}
+	return errors.Join(errs...)
+}
+
+// RemoveFinalizer calls each Finalizer's RemoveFinalizer method serially. It
+// calls every Finalizer even if some fail, and returns all errors it
+// encounters, joined.
+func (m MultiFinalizer) RemoveFinalizer(ctx context.Context, obj Object) error {
+	errs := make([]error, 0, len(m))
+	for _, f := range m {
+		errs = append(errs, f.RemoveFinalizer(ctx, obj))
+	}
+	return errors.Join(errs...)
 }
 
 // A FinalizerFns satisfy the Finalizer interface.
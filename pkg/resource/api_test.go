@@ -21,11 +21,14 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
@@ -281,6 +284,135 @@ func TestAPIUpdatingApplicator(t *testing.T) {
 	}
 }
 
+func TestAPIApplicatorDryRun(t *testing.T) {
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool-existing"},
+		Data:       map[string]string{"cool": "old"},
+	}
+
+	type args struct {
+		newApplicator func(c client.Client) Applicator
+		name          string
+	}
+
+	type want struct {
+		dryRun  bool
+		applied map[string]string
+		stored  map[string]string
+	}
+
+	patching := func(c client.Client) Applicator { return NewAPIPatchingApplicator(c, WithDryRun()) }
+	updating := func(c client.Client) Applicator { return NewAPIUpdatingApplicator(c, WithDryRun()) }
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"PatchingCreate": {
+			reason: "A dry-run patching applicator should not persist an object it creates.",
+			args: args{
+				newApplicator: patching,
+				name:          "cool-new",
+			},
+			want: want{
+				dryRun:  true,
+				applied: map[string]string{"cool": "new"},
+			},
+		},
+		"PatchingPatch": {
+			reason: "A dry-run patching applicator should not persist the patch.",
+			args: args{
+				newApplicator: patching,
+				name:          existing.GetName(),
+			},
+			want: want{
+				dryRun: true,
+				// The fake client returns early from a dry-run patch, so
+				// unlike an API server it doesn't return the projected
+				// object. We're left with the object we read before patching.
+				applied: map[string]string{"cool": "old"},
+				stored:  map[string]string{"cool": "old"},
+			},
+		},
+		"UpdatingCreate": {
+			reason: "A dry-run updating applicator should not persist an object it creates.",
+			args: args{
+				newApplicator: updating,
+				name:          "cool-new",
+			},
+			want: want{
+				dryRun:  true,
+				applied: map[string]string{"cool": "new"},
+			},
+		},
+		"UpdatingUpdate": {
+			reason: "A dry-run updating applicator should return the projected object without persisting the update.",
+			args: args{
+				newApplicator: updating,
+				name:          existing.GetName(),
+			},
+			want: want{
+				dryRun:  true,
+				applied: map[string]string{"cool": "new"},
+				stored:  map[string]string{"cool": "old"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			dryRun := func(opts []string) {
+				got.dryRun = len(opts) == 1 && opts[0] == metav1.DryRunAll
+			}
+			c := ctrlfake.NewClientBuilder().
+				WithObjects(existing.DeepCopy()).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						co := &client.CreateOptions{}
+						co.ApplyOptions(opts)
+						dryRun(co.DryRun)
+						return c.Create(ctx, obj, opts...)
+					},
+					Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						po := &client.PatchOptions{}
+						po.ApplyOptions(opts)
+						dryRun(po.DryRun)
+						return c.Patch(ctx, obj, patch, opts...)
+					},
+					Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+						uo := &client.UpdateOptions{}
+						uo.ApplyOptions(opts)
+						dryRun(uo.DryRun)
+						return c.Update(ctx, obj, opts...)
+					},
+				}).
+				Build()
+
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: tc.args.name},
+				Data:       map[string]string{"cool": "new"},
+			}
+			if err := tc.args.newApplicator(c).Apply(context.Background(), cm); err != nil {
+				t.Fatalf("\n%s\nApply(...): unexpected error: %s", tc.reason, err)
+			}
+			got.applied = cm.Data
+
+			stored := &corev1.ConfigMap{}
+			err := c.Get(context.Background(), client.ObjectKeyFromObject(cm), stored)
+			if err != nil && !kerrors.IsNotFound(err) {
+				t.Fatalf("\n%s\nGet(...): unexpected error: %s", tc.reason, err)
+			}
+			got.stored = stored.Data
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want, +got\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestManagedRemoveFinalizer(t *testing.T) {
 	finalizer := "veryfinal"
 
@@ -323,6 +455,56 @@ func TestManagedRemoveFinalizer(t *testing.T) {
 				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{}}},
 			},
 		},
+		"ConflictThenSuccessful": {
+			client: &test.MockClient{
+				MockUpdate: conflictOnce(),
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					// Another controller added its finalizer.
+					obj.SetFinalizers([]string{"other", finalizer})
+					return nil
+				}),
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"other"}}},
+			},
+		},
+		"ConflictAlreadyRemoved": {
+			client: &test.MockClient{
+				MockUpdate: conflictOnce(),
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					// Another controller removed our finalizer.
+					obj.SetFinalizers([]string{"other"})
+					return nil
+				}),
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"other"}}},
+			},
+		},
+		"ConflictGetError": {
+			client: &test.MockClient{
+				MockUpdate: conflictOnce(),
+				MockGet:    test.NewMockGetFn(errBoom),
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateObject),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{}}},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -339,6 +521,19 @@ func TestManagedRemoveFinalizer(t *testing.T) {
 	}
 }
 
+// conflictOnce returns a MockUpdateFn that returns a conflict error the first
+// time it is called, and succeeds thereafter.
+func conflictOnce() test.MockUpdateFn {
+	called := false
+	return func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+		if !called {
+			called = true
+			return kerrors.NewConflict(schema.GroupResource{}, "", errors.New("conflict"))
+		}
+		return nil
+	}
+}
+
 func TestAPIFinalizerAdder(t *testing.T) {
 	finalizer := "veryfinal"
 
@@ -396,3 +591,56 @@ func TestAPIFinalizerAdder(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiFinalizer(t *testing.T) {
+	errBoom := errors.New("boom")
+	errBang := errors.New("bang")
+
+	ok := FinalizerFns{
+		AddFinalizerFn:    func(_ context.Context, _ Object) error { return nil },
+		RemoveFinalizerFn: func(_ context.Context, _ Object) error { return nil },
+	}
+	fails := func(err error) Finalizer {
+		return FinalizerFns{
+			AddFinalizerFn:    func(_ context.Context, _ Object) error { return err },
+			RemoveFinalizerFn: func(_ context.Context, _ Object) error { return err },
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		f      MultiFinalizer
+		want   error
+	}{
+		"Empty": {
+			reason: "An empty MultiFinalizer should succeed.",
+		},
+		"AllSucceed": {
+			reason: "A MultiFinalizer should succeed if all of its Finalizers succeed.",
+			f:      MultiFinalizer{ok, ok},
+		},
+		"OneFails": {
+			reason: "A MultiFinalizer should return the joined error of its only failing Finalizer.",
+			f:      MultiFinalizer{ok, fails(errBoom)},
+			want:   errors.Join(errBoom),
+		},
+		"SomeFail": {
+			reason: "A MultiFinalizer should return the errors of all of its failing Finalizers.",
+			f:      MultiFinalizer{fails(errBoom), ok, fails(errBang)},
+			want:   errors.Join(errBoom, errBang),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.f.AddFinalizer(context.Background(), &fake.Managed{})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAddFinalizer(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			err = tc.f.RemoveFinalizer(context.Background(), &fake.Managed{})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRemoveFinalizer(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
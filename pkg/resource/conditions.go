@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+)
+
+const (
+	errGetConditions = "cannot get status.conditions"
+	errSetConditions = "cannot set status.conditions"
+)
+
+var _ Conditioned = &UnstructuredConditioned{}
+
+// GetConditions returns the conditions at status.conditions of the supplied
+// unstructured object. It returns no conditions if the object has none.
+func GetConditions(u *kunstructured.Unstructured) ([]xpv1.Condition, error) {
+	conditioned := xpv1.ConditionedStatus{}
+	if err := fieldpath.Pave(u.Object).GetValueInto("status", &conditioned); err != nil && !fieldpath.IsNotFound(err) {
+		return nil, errors.Wrap(err, errGetConditions)
+	}
+	return conditioned.Conditions, nil
+}
+
+// SetConditions sets the supplied conditions at status.conditions of the
+// supplied unstructured object, replacing any existing conditions of the same
+// type.
+func SetConditions(u *kunstructured.Unstructured, c ...xpv1.Condition) error {
+	existing, err := GetConditions(u)
+	if err != nil {
+		return err
+	}
+	conditioned := xpv1.ConditionedStatus{Conditions: existing}
+	conditioned.SetConditions(c...)
+	return errors.Wrap(fieldpath.Pave(u.Object).SetValue("status.conditions", conditioned.Conditions), errSetConditions)
+}
+
+// An UnstructuredConditioned wraps an unstructured object so that it satisfies
+// the Conditioned interface. Its conditions are read from and written to
+// status.conditions.
+type UnstructuredConditioned struct {
+	*kunstructured.Unstructured
+}
+
+// NewUnstructuredConditioned returns a Conditioned backed by the supplied
+// unstructured object.
+func NewUnstructuredConditioned(u *kunstructured.Unstructured) *UnstructuredConditioned {
+	return &UnstructuredConditioned{Unstructured: u}
+}
+
+// GetCondition of the unstructured object. It returns an Unknown condition of
+// the supplied type if the object has no such condition, or its conditions
+// can't be read.
+func (c *UnstructuredConditioned) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	existing, _ := GetConditions(c.Unstructured)
+	conditioned := xpv1.ConditionedStatus{Conditions: existing}
+	return conditioned.GetCondition(ct)
+}
+
+// SetConditions of the unstructured object.
+func (c *UnstructuredConditioned) SetConditions(cs ...xpv1.Condition) {
+	_ = SetConditions(c.Unstructured, cs...)
+}
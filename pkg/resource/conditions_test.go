@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestUnstructuredConditions(t *testing.T) {
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+
+	synced := xpv1.Condition{
+		Type:               xpv1.TypeSynced,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: earlier,
+		Reason:             xpv1.ReasonReconcileSuccess,
+		ObservedGeneration: 2,
+	}
+	ready := xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             xpv1.ReasonCreating,
+		Message:            "coolMessage",
+		ObservedGeneration: 3,
+	}
+
+	type args struct {
+		u *kunstructured.Unstructured
+		c []xpv1.Condition
+	}
+	type want struct {
+		c []xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoExistingConditions": {
+			reason: "Setting conditions on an object without a conditions array should create it.",
+			args: args{
+				u: &kunstructured.Unstructured{Object: map[string]any{}},
+				c: []xpv1.Condition{synced, ready},
+			},
+			want: want{
+				c: []xpv1.Condition{synced, ready},
+			},
+		},
+		"ExistingConditions": {
+			reason: "Setting conditions on an object with existing conditions should replace those of the same type and preserve the rest.",
+			args: args{
+				u: func() *kunstructured.Unstructured {
+					u := &kunstructured.Unstructured{Object: map[string]any{}}
+					if err := SetConditions(u, synced, xpv1.Creating()); err != nil {
+						t.Fatal(err)
+					}
+					return u
+				}(),
+				c: []xpv1.Condition{ready},
+			},
+			want: want{
+				c: []xpv1.Condition{synced, ready},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := SetConditions(tc.args.u, tc.args.c...); err != nil {
+				t.Fatalf("\n%s\nSetConditions(...): unexpected error: %v", tc.reason, err)
+			}
+			got, err := GetConditions(tc.args.u)
+			if err != nil {
+				t.Fatalf("\n%s\nGetConditions(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("\n%s\nGetConditions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUnstructuredConditioned(t *testing.T) {
+	c := xpv1.Available().WithObservedGeneration(4)
+
+	cases := map[string]struct {
+		reason string
+		u      *kunstructured.Unstructured
+		set    []xpv1.Condition
+		get    xpv1.ConditionType
+		want   xpv1.Condition
+	}{
+		"NoConditions": {
+			reason: "An object without conditions should return an Unknown condition.",
+			u:      &kunstructured.Unstructured{Object: map[string]any{}},
+			get:    xpv1.TypeReady,
+			want:   xpv1.Condition{Type: xpv1.TypeReady, Status: corev1.ConditionUnknown},
+		},
+		"SetThenGet": {
+			reason: "A condition that was set should be returned unchanged.",
+			u:      &kunstructured.Unstructured{Object: map[string]any{"status": map[string]any{"conditions": []any{}}}},
+			set:    []xpv1.Condition{c},
+			get:    xpv1.TypeReady,
+			want:   c,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			uc := NewUnstructuredConditioned(tc.u)
+			uc.SetConditions(tc.set...)
+			got := uc.GetCondition(tc.get)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nGetCondition(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+)
+
+const (
+	errPaveObject            = "cannot pave object"
+	errFmtGetDeprecatedField = "cannot get deprecated field %q"
+)
+
+// A DeprecatedField is a field of a resource that is deprecated, and will be
+// removed in a future version of its API.
+type DeprecatedField struct {
+	// Path to the field, for example spec.forProvider.region.
+	Path string
+
+	// Message explaining what to do instead of setting the field, if
+	// anything. For example "use spec.forProvider.location instead".
+	Message string
+}
+
+// DeprecatedFieldsInUse returns those of the supplied deprecated fields that
+// are set in the supplied object, in the order they were supplied. A field is
+// set if it's present in the object's JSON serialization.
+func DeprecatedFieldsInUse(o runtime.Object, fields ...DeprecatedField) ([]DeprecatedField, error) {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return nil, errors.Wrap(err, errPaveObject)
+	}
+	var inUse []DeprecatedField
+	for _, f := range fields {
+		_, err := p.GetValue(f.Path)
+		if fieldpath.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtGetDeprecatedField, f.Path)
+		}
+		inUse = append(inUse, f)
+	}
+	return inUse, nil
+}
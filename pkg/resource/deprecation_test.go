@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDeprecatedFieldsInUse(t *testing.T) {
+	region := DeprecatedField{Path: "spec.forProvider.region", Message: "use spec.forProvider.location instead"}
+	tags := DeprecatedField{Path: "spec.forProvider.tags[0]"}
+
+	type want struct {
+		inUse []DeprecatedField
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		obj    map[string]any
+		fields []DeprecatedField
+		want   want
+	}{
+		"Set": {
+			reason: "A deprecated field that is set should be returned.",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"region": "us-east-1",
+					},
+				},
+			},
+			fields: []DeprecatedField{region},
+			want: want{
+				inUse: []DeprecatedField{region},
+			},
+		},
+		"NotSet": {
+			reason: "A deprecated field that isn't set shouldn't be returned.",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"location": "us-east-1",
+					},
+				},
+			},
+			fields: []DeprecatedField{region},
+			want:   want{},
+		},
+		"SomeSet": {
+			reason: "Only the deprecated fields that are set should be returned, in the order they were supplied.",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"tags": []any{"cool"},
+					},
+				},
+			},
+			fields: []DeprecatedField{region, tags},
+			want: want{
+				inUse: []DeprecatedField{tags},
+			},
+		},
+		"NoFields": {
+			reason: "No deprecated fields should be returned if none are supplied.",
+			obj: map[string]any{
+				"spec": map[string]any{},
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			inUse, err := DeprecatedFieldsInUse(&kunstructured.Unstructured{Object: tc.obj}, tc.fields...)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("\n%s\nDeprecatedFieldsInUse(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.inUse, inUse); diff != "" {
+				t.Errorf("\n%s\nDeprecatedFieldsInUse(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ManagedOption configures a Managed built by NewManaged.
+type ManagedOption func(m *Managed)
+
+// NewManaged returns a Managed configured by the supplied options.
+func NewManaged(o ...ManagedOption) *Managed {
+	m := &Managed{}
+	for _, fn := range o {
+		fn(m)
+	}
+	return m
+}
+
+// WithName sets the name of a Managed.
+func WithName(name string) ManagedOption {
+	return func(m *Managed) { m.SetName(name) }
+}
+
+// WithGeneration sets the generation of a Managed.
+func WithGeneration(g int64) ManagedOption {
+	return func(m *Managed) { m.SetGeneration(g) }
+}
+
+// WithAnnotations adds the supplied annotations to a Managed.
+func WithAnnotations(a map[string]string) ManagedOption {
+	return func(m *Managed) {
+		if m.Annotations == nil {
+			m.Annotations = make(map[string]string, len(a))
+		}
+		for k, v := range a {
+			m.Annotations[k] = v
+		}
+	}
+}
+
+// WithFinalizers sets the finalizers of a Managed.
+func WithFinalizers(f ...string) ManagedOption {
+	return func(m *Managed) { m.SetFinalizers(f) }
+}
+
+// WithDeletionTimestamp sets the deletion timestamp of a Managed.
+func WithDeletionTimestamp(t time.Time) ManagedOption {
+	return func(m *Managed) {
+		dt := metav1.NewTime(t)
+		m.SetDeletionTimestamp(&dt)
+	}
+}
+
+// WithPolicies sets the management policies of a Managed.
+func WithPolicies(p ...xpv1.ManagementAction) ManagedOption {
+	return func(m *Managed) { m.SetManagementPolicies(p) }
+}
+
+// WithDeletionPolicy sets the deletion policy of a Managed.
+func WithDeletionPolicy(p xpv1.DeletionPolicy) ManagedOption {
+	return func(m *Managed) { m.SetDeletionPolicy(p) }
+}
+
+// WithProviderConfigReference sets the provider config reference of a
+// Managed.
+func WithProviderConfigReference(r *xpv1.Reference) ManagedOption {
+	return func(m *Managed) { m.SetProviderConfigReference(r) }
+}
+
+// WithConnectionSecretReference sets the connection secret reference of a
+// Managed.
+func WithConnectionSecretReference(r *xpv1.SecretReference) ManagedOption {
+	return func(m *Managed) { m.SetWriteConnectionSecretToReference(r) }
+}
+
+// WithConditions sets the supplied conditions on a Managed.
+func WithConditions(c ...xpv1.Condition) ManagedOption {
+	return func(m *Managed) { m.SetConditions(c...) }
+}
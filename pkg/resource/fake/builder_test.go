@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestNewManaged(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	cases := map[string]struct {
+		reason string
+		o      []ManagedOption
+		want   func() *Managed
+	}{
+		"NoOptions": {
+			reason: "A Managed built without options should be empty.",
+			want:   func() *Managed { return &Managed{} },
+		},
+		"AllOptions": {
+			reason: "A Managed built with options should be equivalent to one configured using its setters.",
+			o: []ManagedOption{
+				WithName("cool"),
+				WithGeneration(2),
+				WithAnnotations(map[string]string{"a": "b"}),
+				WithAnnotations(map[string]string{"c": "d"}),
+				WithFinalizers("finalizer"),
+				WithDeletionTimestamp(now),
+				WithPolicies(xpv1.ManagementActionObserve),
+				WithDeletionPolicy(xpv1.DeletionOrphan),
+				WithProviderConfigReference(&xpv1.Reference{Name: "config"}),
+				WithConnectionSecretReference(&xpv1.SecretReference{Name: "secret", Namespace: "ns"}),
+				WithConditions(xpv1.Available(), xpv1.ReconcileSuccess()),
+			},
+			want: func() *Managed {
+				m := &Managed{}
+				m.SetName("cool")
+				m.SetGeneration(2)
+				m.SetAnnotations(map[string]string{"a": "b", "c": "d"})
+				m.SetFinalizers([]string{"finalizer"})
+				dt := metav1.NewTime(now)
+				m.SetDeletionTimestamp(&dt)
+				m.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionObserve})
+				m.SetDeletionPolicy(xpv1.DeletionOrphan)
+				m.SetProviderConfigReference(&xpv1.Reference{Name: "config"})
+				m.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "secret", Namespace: "ns"})
+				m.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+				return m
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewManaged(tc.o...)
+			if diff := cmp.Diff(tc.want(), got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nNewManaged(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -301,6 +301,16 @@ func (m *UserCounter) GetUsers() int64 {
 	return m.Users
 }
 
+// ExternalNameAccessor is a mock that implements ExternalNameAccessor
+// interface.
+type ExternalNameAccessor struct{ ExternalName string }
+
+// GetExternalName gets the ExternalName.
+func (m *ExternalNameAccessor) GetExternalName() string { return m.ExternalName }
+
+// SetExternalName sets the ExternalName.
+func (m *ExternalNameAccessor) SetExternalName(name string) { m.ExternalName = name }
+
 // Object is a mock that implements Object interface.
 type Object struct {
 	metav1.ObjectMeta
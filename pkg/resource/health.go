@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+)
+
+const (
+	errListHealth       = "cannot list resources"
+	errFmtGetConditions = "cannot get conditions of %s"
+)
+
+// ConditionCounts counts how many resources have a condition of a particular
+// type in each status.
+type ConditionCounts struct {
+	True    int `json:"true"`
+	False   int `json:"false"`
+	Unknown int `json:"unknown"`
+}
+
+func (c *ConditionCounts) add(s corev1.ConditionStatus) {
+	switch s {
+	case corev1.ConditionTrue:
+		c.True++
+	case corev1.ConditionFalse:
+		c.False++
+	default:
+		c.Unknown++
+	}
+}
+
+// A HealthSummary aggregates the Ready and Synced conditions of all resources
+// of a particular kind. It is suitable for serializing to JSON, for example to
+// be served by an HTTP handler.
+type HealthSummary struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+
+	// Total number of resources of this kind.
+	Total int `json:"total"`
+
+	// Ready counts the status of each resource's Ready condition. Resources
+	// without a Ready condition are counted as Unknown.
+	Ready ConditionCounts `json:"ready"`
+
+	// Synced counts the status of each resource's Synced condition. Resources
+	// without a Synced condition are counted as Unknown.
+	Synced ConditionCounts `json:"synced"`
+}
+
+// SummarizeHealth lists all resources of the supplied kind and aggregates
+// their Ready and Synced conditions. The supplied kind must be the kind of the
+// resource, not of its list. SummarizeHealth only reads from the supplied
+// client, so a cache-backed client may be used.
+func SummarizeHealth(ctx context.Context, c client.Reader, gvk schema.GroupVersionKind, o ...client.ListOption) (*HealthSummary, error) {
+	l := &kunstructured.UnstructuredList{}
+	l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := c.List(ctx, l, o...); err != nil {
+		return nil, errors.Wrap(err, errListHealth)
+	}
+
+	h := &HealthSummary{APIVersion: gvk.GroupVersion().String(), Kind: gvk.Kind, Total: len(l.Items)}
+	for _, u := range l.Items {
+		cs := xpv1.ConditionedStatus{}
+		if err := fieldpath.Pave(u.Object).GetValueInto("status.conditions", &cs.Conditions); err != nil && !fieldpath.IsNotFound(err) {
+			return nil, errors.Wrapf(err, errFmtGetConditions, u.GetName())
+		}
+		h.Ready.add(cs.GetCondition(xpv1.TypeReady).Status)
+		h.Synced.add(cs.GetCondition(xpv1.TypeSynced).Status)
+	}
+	return h, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func withConditions(name string, c ...xpv1.Condition) kunstructured.Unstructured {
+	u := kunstructured.Unstructured{Object: map[string]any{}}
+	u.SetName(name)
+	if len(c) == 0 {
+		return u
+	}
+	conditions := make([]any, len(c))
+	for i := range c {
+		conditions[i] = map[string]any{
+			"type":   string(c[i].Type),
+			"status": string(c[i].Status),
+			"reason": string(c[i].Reason),
+		}
+	}
+	_ = kunstructured.SetNestedSlice(u.Object, conditions, "status", "conditions")
+	return u
+}
+
+func TestSummarizeHealth(t *testing.T) {
+	errBoom := errors.New("boom")
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+	type want struct {
+		h   *HealthSummary
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Reader
+		want   want
+	}{
+		"ListError": {
+			reason: "Errors listing resources should be returned.",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(errBoom),
+			},
+			want: want{err: errors.Wrap(errBoom, errListHealth)},
+		},
+		"NoResources": {
+			reason: "A kind with no resources should have all counts set to zero.",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil),
+			},
+			want: want{h: &HealthSummary{APIVersion: "example.org/v1", Kind: "Cool"}},
+		},
+		"MixedResources": {
+			reason: "The Ready and Synced conditions of each resource should be counted.",
+			c: &test.MockClient{
+				MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+					l := obj.(*kunstructured.UnstructuredList)
+					if diff := cmp.Diff(gvk.GroupVersion().WithKind("CoolList"), l.GroupVersionKind()); diff != "" {
+						t.Errorf("List(...): -want GVK, +got GVK:\n%s", diff)
+					}
+					l.Items = []kunstructured.Unstructured{
+						withConditions("ready", xpv1.Available(), xpv1.ReconcileSuccess()),
+						withConditions("also-ready", xpv1.Available(), xpv1.ReconcileError(errBoom)),
+						withConditions("not-ready", xpv1.Creating(), xpv1.ReconcileSuccess()),
+						withConditions("new"),
+					}
+					return nil
+				}),
+			},
+			want: want{h: &HealthSummary{
+				APIVersion: "example.org/v1",
+				Kind:       "Cool",
+				Total:      4,
+				Ready:      ConditionCounts{True: 2, False: 1, Unknown: 1},
+				Synced:     ConditionCounts{True: 2, False: 1, Unknown: 1},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h, err := SummarizeHealth(context.Background(), tc.c, gvk)
+			if diff := cmp.Diff(tc.want.h, h); diff != "" {
+				t.Errorf("\n%s\nSummarizeHealth(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSummarizeHealth(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+)
+
+// Fields indexed by AddCommonIndexes.
+const (
+	// IndexKeyProviderConfigRef indexes objects by the name of the
+	// ProviderConfig they reference.
+	IndexKeyProviderConfigRef = "spec.providerConfigRef.name"
+
+	// IndexKeyReferences indexes objects by the names of the objects they
+	// reference using the spec.forProvider.xRef and xRefs fields.
+	IndexKeyReferences = "crossplane.io/references"
+)
+
+const errFmtAddIndex = "cannot add index %q for %T"
+
+// A FieldIndexerGetter returns a client.FieldIndexer. A controller-runtime
+// manager.Manager satisfies this interface.
+type FieldIndexerGetter interface {
+	GetFieldIndexer() client.FieldIndexer
+}
+
+//nolint:gochecknoglobals // We treat this as a constant.
+var commonIndexes = []struct {
+	field string
+	fn    client.IndexerFunc
+}{
+	{field: IndexKeyProviderConfigRef, fn: IndexProviderConfigRef},
+	{field: IndexKeyReferences, fn: IndexReferences},
+}
+
+// AddCommonIndexes registers the IndexKeyProviderConfigRef and
+// IndexKeyReferences indexes for the supplied types of object with the
+// supplied manager's cache. Objects may then be listed by these fields using
+// client.MatchingFields. It is safe to call AddCommonIndexes more than once
+// for the same type of object.
+func AddCommonIndexes(mgr FieldIndexerGetter, objs ...client.Object) error {
+	fi := mgr.GetFieldIndexer()
+	for _, o := range objs {
+		for _, idx := range commonIndexes {
+			err := fi.IndexField(context.Background(), o, idx.field, idx.fn)
+			if err != nil && !isIndexConflict(err) {
+				return errors.Wrapf(err, errFmtAddIndex, idx.field, o)
+			}
+		}
+	}
+	return nil
+}
+
+// isIndexConflict returns true if the supplied error indicates that an index
+// was already registered. FieldIndexers don't return a typed error when an
+// index is registered twice, so we match on the messages they're known to
+// return.
+func isIndexConflict(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "indexer conflict") || strings.Contains(msg, "already exists")
+}
+
+// IndexProviderConfigRef returns the name of the ProviderConfig referenced by
+// the supplied object, if any.
+func IndexProviderConfigRef(o client.Object) []string {
+	p, err := pave(o)
+	if err != nil {
+		return nil
+	}
+	name, err := p.GetString("spec.providerConfigRef.name")
+	if err != nil || name == "" {
+		return nil
+	}
+	return []string{name}
+}
+
+// IndexReferences returns the sorted names of the objects referenced by the
+// xRef and xRefs fields of the supplied object's spec.forProvider and
+// spec.initProvider.
+func IndexReferences(o client.Object) []string {
+	p, err := pave(o)
+	if err != nil {
+		return nil
+	}
+	names := map[string]bool{}
+	for _, path := range []string{"spec.forProvider", "spec.initProvider"} {
+		v, err := p.GetValue(path)
+		if err != nil {
+			continue
+		}
+		referencedNames(v, names)
+	}
+	out := make([]string, 0, len(names))
+	for n := range names {
+		out = append(out, n)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func pave(o client.Object) (*fieldpath.Paved, error) {
+	if u, ok := o.(runtime.Unstructured); ok {
+		return fieldpath.Pave(u.UnstructuredContent()), nil
+	}
+	return fieldpath.PaveObject(o)
+}
+
+// referencedNames adds the names of the resources referenced by the Ref and
+// Refs fields found in the supplied JSON value to the supplied set.
+func referencedNames(v any, names map[string]bool) {
+	WalkReferenceFields(v, func(_, field string, value any) {
+		if !IsReferenceField(field) {
+			return
+		}
+		for _, n := range ReferenceNames(value) {
+			names[n] = true
+		}
+	})
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	cfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// An indexRecorder is a FieldIndexer that records the indexes registered with
+// it. Like most FieldIndexers it returns an error if an index is registered
+// twice.
+type indexRecorder struct {
+	indexes map[string]client.IndexerFunc
+}
+
+func (r *indexRecorder) IndexField(_ context.Context, _ client.Object, field string, fn client.IndexerFunc) error {
+	if _, ok := r.indexes[field]; ok {
+		return fmt.Errorf("indexer conflict: %s", field)
+	}
+	r.indexes[field] = fn
+	return nil
+}
+
+func (r *indexRecorder) GetFieldIndexer() client.FieldIndexer {
+	return r
+}
+
+func TestAddCommonIndexes(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+	newCool := func(name string, spec map[string]any) *kunstructured.Unstructured {
+		u := &kunstructured.Unstructured{Object: map[string]any{"spec": spec}}
+		u.SetGroupVersionKind(gvk)
+		u.SetName(name)
+		return u
+	}
+
+	objs := []client.Object{
+		newCool("a", map[string]any{
+			"providerConfigRef": map[string]any{"name": "default"},
+			"forProvider": map[string]any{
+				"vpcIdRef":       map[string]any{"name": "cool-vpc"},
+				"securityGroups": map[string]any{"groupIdRefs": []any{map[string]any{"name": "cool-sg"}, map[string]any{"name": "other-sg"}}},
+			},
+		}),
+		newCool("b", map[string]any{
+			"providerConfigRef": map[string]any{"name": "other"},
+			"forProvider":       map[string]any{"vpcIdRef": map[string]any{"name": "cool-vpc"}},
+		}),
+		newCool("c", map[string]any{
+			"providerConfigRef": map[string]any{"name": "default"},
+		}),
+	}
+
+	r := &indexRecorder{indexes: map[string]client.IndexerFunc{}}
+	for i := 0; i < 2; i++ {
+		if err := AddCommonIndexes(r, &kunstructured.Unstructured{}); err != nil {
+			t.Fatalf("AddCommonIndexes(...), call %d: %s", i, err)
+		}
+	}
+
+	b := cfake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(objs...)
+	for field, fn := range r.indexes {
+		b = b.WithIndex(newCool("", nil), field, fn)
+	}
+	c := b.Build()
+
+	cases := map[string]struct {
+		reason string
+		field  string
+		value  string
+		want   []string
+	}{
+		"ProviderConfigRef": {
+			reason: "Listing by provider config should return the objects that reference it.",
+			field:  IndexKeyProviderConfigRef,
+			value:  "default",
+			want:   []string{"a", "c"},
+		},
+		"Reference": {
+			reason: "Listing by a referenced name should return the objects that reference it.",
+			field:  IndexKeyReferences,
+			value:  "cool-vpc",
+			want:   []string{"a", "b"},
+		},
+		"ReferenceList": {
+			reason: "Listing by a name referenced in a list of references should return the objects that reference it.",
+			field:  IndexKeyReferences,
+			value:  "other-sg",
+			want:   []string{"a"},
+		},
+		"NoMatch": {
+			reason: "Listing by an unreferenced name should return no objects.",
+			field:  IndexKeyReferences,
+			value:  "default",
+			want:   []string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &kunstructured.UnstructuredList{}
+			l.SetGroupVersionKind(gvk.GroupVersion().WithKind("CoolList"))
+			if err := c.List(context.Background(), l, client.MatchingFields{tc.field: tc.value}); err != nil {
+				t.Fatalf("\n%s\nc.List(...): %s", tc.reason, err)
+			}
+			got := make([]string, 0, len(l.Items))
+			for _, u := range l.Items {
+				got = append(got, u.GetName())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nc.List(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type indexFieldFn func(ctx context.Context, obj client.Object, field string, fn client.IndexerFunc) error
+
+func (f indexFieldFn) IndexField(ctx context.Context, obj client.Object, field string, fn client.IndexerFunc) error {
+	return f(ctx, obj, field, fn)
+}
+
+func (f indexFieldFn) GetFieldIndexer() client.FieldIndexer {
+	return f
+}
+
+func TestAddCommonIndexesError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		fi     indexFieldFn
+		want   error
+	}{
+		"IndexConflict": {
+			reason: "We should ignore errors indicating an index was already registered.",
+			fi: func(_ context.Context, _ client.Object, field string, _ client.IndexerFunc) error {
+				return fmt.Errorf("indexer conflict: %s", field)
+			},
+			want: nil,
+		},
+		"AlreadyExists": {
+			reason: "We should ignore errors indicating an index already exists.",
+			fi: func(_ context.Context, _ client.Object, field string, _ client.IndexerFunc) error {
+				return fmt.Errorf("index with name %s already exists", field)
+			},
+			want: nil,
+		},
+		"OtherError": {
+			reason: "We should return any other error.",
+			fi: func(_ context.Context, _ client.Object, _ string, _ client.IndexerFunc) error {
+				return errBoom
+			},
+			want: errors.Wrapf(errBoom, errFmtAddIndex, IndexKeyProviderConfigRef, &kunstructured.Unstructured{}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := AddCommonIndexes(tc.fi, &kunstructured.Unstructured{})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAddCommonIndexes(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -35,6 +35,13 @@ type Conditioned interface {
 	GetCondition(ct xpv1.ConditionType) xpv1.Condition
 }
 
+// A LastReconcileTimeSetter may record when it was last successfully
+// reconciled, and the generation of its spec that was reconciled.
+type LastReconcileTimeSetter interface {
+	SetLastReconcileTime(t metav1.Time)
+	SetLastSyncedGeneration(g int64)
+}
+
 // A ClaimReferencer may reference a resource claim.
 type ClaimReferencer interface {
 	SetClaimReference(r *reference.Claim)
@@ -99,6 +106,15 @@ type RequiredTypedResourceReferencer interface {
 	GetResourceReference() xpv1.TypedReference
 }
 
+// An ExternalNameAccessor stores its external name somewhere other than the
+// external name annotation, for example in a spec field. Managed resources that
+// satisfy this interface are read from and written to using its methods rather
+// than the annotation.
+type ExternalNameAccessor interface {
+	GetExternalName() string
+	SetExternalName(name string)
+}
+
 // A Finalizer manages the finalizers on the resource.
 type Finalizer interface {
 	AddFinalizer(ctx context.Context, obj Object) error
@@ -17,10 +17,14 @@ limitations under the License.
 package resource
 
 import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 )
 
@@ -42,6 +46,56 @@ func NewPredicates(fn PredicateFn) predicate.Funcs {
 	}
 }
 
+// HasManagementPolicy accepts objects whose management policies include any of
+// the supplied management actions. Objects that don't support management
+// policies are rejected. The new object is checked during updates.
+func HasManagementPolicy(actions ...xpv1.ManagementAction) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		m, ok := o.(Manageable)
+		if !ok {
+			return false
+		}
+		for _, p := range m.GetManagementPolicies() {
+			for _, a := range actions {
+				if p == a {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// HasAnnotation accepts objects that have the supplied annotation with the
+// supplied value. The new object is checked during updates.
+func HasAnnotation(key, value string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		v, ok := o.GetAnnotations()[key]
+		return ok && v == value
+	})
+}
+
+// LacksAnnotation accepts objects that don't have the supplied annotation. The
+// new object is checked during updates.
+func LacksAnnotation(key string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		_, ok := o.GetAnnotations()[key]
+		return !ok
+	})
+}
+
+// PredicateAnd accepts events that are accepted by all of the supplied
+// predicates.
+func PredicateAnd(p ...predicate.Predicate) predicate.Predicate {
+	return predicate.And(p...)
+}
+
+// PredicateOr accepts events that are accepted by any of the supplied
+// predicates.
+func PredicateOr(p ...predicate.Predicate) predicate.Predicate {
+	return predicate.Or(p...)
+}
+
 // DesiredStateChanged accepts objects that have changed their desired state, i.e.
 // the state that is not managed by the controller.
 // To be more specific, it accepts update events that have changes in one of the followings:
@@ -119,3 +173,61 @@ func (a AnnotationChangedPredicate) Update(e event.UpdateEvent) bool {
 	// annotations unchanged.
 	return false
 }
+
+// IgnoreNoOpStatusUpdates rejects update events that don't change an object,
+// other than its resource version, its managed fields, or the last transition
+// times of its status conditions. Updating an object's status changes its
+// resource version, and may change the last transition times of its
+// conditions even though the conditions are otherwise unchanged. Ignoring
+// these no-op updates prevents a controller that watches the objects it
+// reconciles from triggering itself. This is the recommended event filter
+// for managed resource controllers, for example using the controller
+// builder's WithEventFilter. Events other than updates are accepted, as are
+// the update events an informer delivers when it resyncs. The latter don't
+// change the resource version, and are how resources that won't otherwise be
+// requeued are periodically reconciled.
+func IgnoreNoOpStatusUpdates() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return true
+			}
+			if e.ObjectOld.GetResourceVersion() == e.ObjectNew.GetResourceVersion() {
+				return true
+			}
+			o, err := withoutVolatileFields(e.ObjectOld)
+			if err != nil {
+				return true
+			}
+			n, err := withoutVolatileFields(e.ObjectNew)
+			if err != nil {
+				return true
+			}
+			return !equality.Semantic.DeepEqual(o, n)
+		},
+	}
+}
+
+// withoutVolatileFields returns an unstructured copy of the supplied object
+// without its resource version, its managed fields, or the last transition
+// times of its status conditions.
+func withoutVolatileFields(obj client.Object) (map[string]any, error) {
+	// The converter returns the content of unstructured objects as is, so we
+	// convert a copy to avoid modifying the supplied object.
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj.DeepCopyObject())
+	if err != nil {
+		return nil, err
+	}
+	unstructured.RemoveNestedField(u, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(u, "metadata", "managedFields")
+	cs, ok, _ := unstructured.NestedSlice(u, "status", "conditions")
+	if !ok {
+		return u, nil
+	}
+	for _, c := range cs {
+		if m, ok := c.(map[string]any); ok {
+			delete(m, "lastTransitionTime")
+		}
+	}
+	return u, unstructured.SetNestedSlice(u, cs, "status", "conditions")
+}
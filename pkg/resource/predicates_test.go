@@ -21,8 +21,11 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	runtimev1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -152,3 +155,178 @@ func TestDesiredStateChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterPredicates(t *testing.T) {
+	paused := map[string]string{meta.AnnotationKeyReconciliationPaused: "true"}
+
+	cases := map[string]struct {
+		reason string
+		p      predicate.Predicate
+		obj    client.Object
+		want   bool
+	}{
+		"HasManagementPolicy": {
+			reason: "Objects with any of the supplied management policies should be accepted.",
+			p:      HasManagementPolicy(runtimev1.ManagementActionObserve, runtimev1.ManagementActionCreate),
+			obj:    fake.NewManaged(fake.WithPolicies(runtimev1.ManagementActionCreate, runtimev1.ManagementActionDelete)),
+			want:   true,
+		},
+		"LacksManagementPolicy": {
+			reason: "Objects without any of the supplied management policies should be rejected.",
+			p:      HasManagementPolicy(runtimev1.ManagementActionObserve),
+			obj:    fake.NewManaged(fake.WithPolicies(runtimev1.ManagementActionDelete)),
+			want:   false,
+		},
+		"NotManageable": {
+			reason: "Objects that don't support management policies should be rejected.",
+			p:      HasManagementPolicy(runtimev1.ManagementActionAll),
+			obj:    &fake.Object{},
+			want:   false,
+		},
+		"HasAnnotation": {
+			reason: "Objects with the supplied annotation value should be accepted.",
+			p:      HasAnnotation(meta.AnnotationKeyReconciliationPaused, "true"),
+			obj:    fake.NewManaged(fake.WithAnnotations(paused)),
+			want:   true,
+		},
+		"HasAnnotationDifferentValue": {
+			reason: "Objects with a different value for the supplied annotation should be rejected.",
+			p:      HasAnnotation(meta.AnnotationKeyReconciliationPaused, "false"),
+			obj:    fake.NewManaged(fake.WithAnnotations(paused)),
+			want:   false,
+		},
+		"LacksAnnotation": {
+			reason: "Objects without the supplied annotation should be accepted.",
+			p:      LacksAnnotation(meta.AnnotationKeyReconciliationPaused),
+			obj:    fake.NewManaged(),
+			want:   true,
+		},
+		"DoesNotLackAnnotation": {
+			reason: "Objects with the supplied annotation should be rejected.",
+			p:      LacksAnnotation(meta.AnnotationKeyReconciliationPaused),
+			obj:    fake.NewManaged(fake.WithAnnotations(paused)),
+			want:   false,
+		},
+		"AndAccepted": {
+			reason: "PredicateAnd should accept objects accepted by all predicates.",
+			p:      PredicateAnd(HasManagementPolicy(runtimev1.ManagementActionAll), LacksAnnotation(meta.AnnotationKeyReconciliationPaused)),
+			obj:    fake.NewManaged(fake.WithPolicies(runtimev1.ManagementActionAll)),
+			want:   true,
+		},
+		"AndRejected": {
+			reason: "PredicateAnd should reject objects rejected by any predicate.",
+			p:      PredicateAnd(HasManagementPolicy(runtimev1.ManagementActionAll), LacksAnnotation(meta.AnnotationKeyReconciliationPaused)),
+			obj:    fake.NewManaged(fake.WithPolicies(runtimev1.ManagementActionAll), fake.WithAnnotations(paused)),
+			want:   false,
+		},
+		"OrAccepted": {
+			reason: "PredicateOr should accept objects accepted by any predicate.",
+			p:      PredicateOr(HasManagementPolicy(runtimev1.ManagementActionObserve), HasAnnotation(meta.AnnotationKeyReconciliationPaused, "true")),
+			obj:    fake.NewManaged(fake.WithAnnotations(paused)),
+			want:   true,
+		},
+		"OrRejected": {
+			reason: "PredicateOr should reject objects rejected by all predicates.",
+			p:      PredicateOr(HasManagementPolicy(runtimev1.ManagementActionObserve), HasAnnotation(meta.AnnotationKeyReconciliationPaused, "true")),
+			obj:    fake.NewManaged(),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.p.Create(event.CreateEvent{Object: tc.obj}); got != tc.want {
+				t.Errorf("\n%s\np.Create(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+			// Updates are filtered based on the new object.
+			if got := tc.p.Update(event.UpdateEvent{ObjectOld: fake.NewManaged(), ObjectNew: tc.obj}); got != tc.want {
+				t.Errorf("\n%s\np.Update(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+			if got := tc.p.Delete(event.DeleteEvent{Object: tc.obj}); got != tc.want {
+				t.Errorf("\n%s\np.Delete(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIgnoreNoOpStatusUpdates(t *testing.T) {
+	then := metav1.NewTime(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(then.Add(time.Minute))
+
+	obj := func(resourceVersion string, atProvider string, c ...runtimev1.Condition) *kunstructured.Unstructured {
+		u := &kunstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.org/v1",
+			"kind":       "Database",
+			"spec":       map[string]any{"forProvider": map[string]any{"region": "us-east-1"}},
+			"status":     map[string]any{"atProvider": map[string]any{"id": atProvider}},
+		}}
+		u.SetName("cool")
+		u.SetResourceVersion(resourceVersion)
+		if err := SetConditions(u, c...); err != nil {
+			t.Fatalf("SetConditions(...): %v", err)
+		}
+		return u
+	}
+	available := func(at metav1.Time) runtimev1.Condition {
+		c := runtimev1.Available()
+		c.LastTransitionTime = at
+		return c
+	}
+
+	cases := map[string]struct {
+		reason string
+		old    *kunstructured.Unstructured
+		new    *kunstructured.Unstructured
+		want   bool
+	}{
+		"ResourceVersionChanged": {
+			reason: "Updates that only change the resource version should be rejected.",
+			old:    obj("1", "abc", available(then)),
+			new:    obj("2", "abc", available(then)),
+			want:   false,
+		},
+		"Resync": {
+			reason: "Updates that don't change the resource version are informer resyncs, and should be accepted.",
+			old:    obj("1", "abc", available(then)),
+			new:    obj("1", "abc", available(then)),
+			want:   true,
+		},
+		"ConditionTimestampsChanged": {
+			reason: "Updates that only change the last transition times of conditions should be rejected.",
+			old:    obj("1", "abc", available(then)),
+			new:    obj("2", "abc", available(now)),
+			want:   false,
+		},
+		"ConditionChanged": {
+			reason: "Updates that change a condition's status should be accepted.",
+			old:    obj("1", "abc", available(then)),
+			new:    obj("2", "abc", runtimev1.Unavailable()),
+			want:   true,
+		},
+		"ConditionAdded": {
+			reason: "Updates that add a condition should be accepted.",
+			old:    obj("1", "abc"),
+			new:    obj("2", "abc", available(then)),
+			want:   true,
+		},
+		"StatusChanged": {
+			reason: "Updates that change the status other than conditions should be accepted.",
+			old:    obj("1", "abc", available(then)),
+			new:    obj("2", "def", available(now)),
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			old := tc.old.DeepCopy()
+			got := IgnoreNoOpStatusUpdates().Update(event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(old, tc.old); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want unmodified old object, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
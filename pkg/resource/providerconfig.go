@@ -19,6 +19,7 @@ package resource
 import (
 	"context"
 	"os"
+	"strings"
 
 	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
@@ -37,6 +38,8 @@ const (
 	errExtractFs             = "cannot extract from filesystem when no path specified"
 	errExtractSecretKey      = "cannot extract from secret key when none specified"
 	errGetCredentialsSecret  = "cannot get credentials secret"
+	errGetSecret             = "cannot get secret"
+	errFmtMissingSecretKeys  = "secret %s/%s is missing required keys: %s"
 	errNoHandlerForSourceFmt = "no extraction handler registered for source: %s"
 	errMissingPCRef          = "managed resource does not reference a ProviderConfig"
 	errApplyPCU              = "cannot apply ProviderConfigUsage"
@@ -86,6 +89,36 @@ func ExtractSecret(ctx context.Context, client client.Client, s xpv1.CommonCrede
 	return secret.Data[s.SecretRef.Key], nil
 }
 
+// GetSecretData returns the data of the referenced Kubernetes secret.
+func GetSecretData(ctx context.Context, client client.Client, ref xpv1.SecretReference) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+	return secret.Data, nil
+}
+
+// ConnectionDetailsFromSecret returns the data of the referenced Kubernetes
+// secret, for example a credentials secret referenced by a managed resource's
+// spec. It returns an error if any of the supplied required keys are missing
+// from the secret.
+func ConnectionDetailsFromSecret(ctx context.Context, client client.Client, ref xpv1.SecretReference, required ...string) (map[string][]byte, error) {
+	data, err := GetSecretData(ctx, client, ref)
+	if err != nil {
+		return nil, err
+	}
+	missing := make([]string, 0, len(required))
+	for _, k := range required {
+		if _, ok := data[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf(errFmtMissingSecretKeys, ref.Namespace, ref.Name, strings.Join(missing, ", "))
+	}
+	return data, nil
+}
+
 // CommonCredentialExtractor extracts credentials from common sources.
 func CommonCredentialExtractor(ctx context.Context, source xpv1.CredentialsSource, client client.Client, selector xpv1.CommonCredentialSelectors) ([]byte, error) {
 	switch source {
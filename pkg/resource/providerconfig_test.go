@@ -233,6 +233,88 @@ func TestExtractSecret(t *testing.T) {
 	}
 }
 
+func TestConnectionDetailsFromSecret(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := xpv1.SecretReference{Name: "super", Namespace: "secret"}
+	data := map[string][]byte{
+		"username": []byte("cool"),
+		"password": []byte("supersecret"),
+	}
+
+	type args struct {
+		client   client.Client
+		ref      xpv1.SecretReference
+		required []string
+	}
+
+	type want struct {
+		data map[string][]byte
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "The data of a secret that contains all required keys should be returned.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(o client.Object) error {
+						o.(*corev1.Secret).Data = data
+						return nil
+					}),
+				},
+				ref:      ref,
+				required: []string{"username", "password"},
+			},
+			want: want{
+				data: data,
+			},
+		},
+		"SecretNotFound": {
+			reason: "An error should be returned if the secret can't be found.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				ref: ref,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"MissingRequiredKey": {
+			reason: "An error should be returned if the secret is missing a required key.",
+			args: args{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(o client.Object) error {
+						o.(*corev1.Secret).Data = data
+						return nil
+					}),
+				},
+				ref:      ref,
+				required: []string{"username", "token", "password", "endpoint"},
+			},
+			want: want{
+				err: errors.Errorf(errFmtMissingSecretKeys, "secret", "super", "token, endpoint"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ConnectionDetailsFromSecret(context.TODO(), tc.args.client, tc.args.ref, tc.args.required...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nConnectionDetailsFromSecret(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.data, got); diff != "" {
+				t.Errorf("\n%s\nConnectionDetailsFromSecret(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestTrack(t *testing.T) {
 	errBoom := errors.New("boom")
 	name := "provisional"
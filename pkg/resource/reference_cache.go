@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// Error strings.
+const (
+	errMarshalManaged   = "cannot marshal managed resource"
+	errUnmarshalManaged = "cannot unmarshal managed resource"
+	errComputeResolved  = "cannot compute resolved reference values"
+	errApplyResolved    = "cannot apply cached resolved reference values"
+)
+
+// A ReferenceResolver resolves references to other resources.
+type ReferenceResolver interface {
+	// ResolveReferences resolves all fields in the supplied managed resource
+	// that are references to other resources.
+	ResolveReferences(ctx context.Context, mg Managed) error
+}
+
+// A ReferenceResolverFn is a function that satisfies the ReferenceResolver
+// interface.
+type ReferenceResolverFn func(ctx context.Context, mg Managed) error
+
+// ResolveReferences calls ReferenceResolverFn function.
+func (fn ReferenceResolverFn) ResolveReferences(ctx context.Context, mg Managed) error {
+	return fn(ctx, mg)
+}
+
+type resolvedReferences struct {
+	refs    string
+	patch   []byte
+	expires time.Time
+}
+
+// A CachingReferenceResolver caches the values resolved by another
+// ReferenceResolver. A managed resource's cached values are used until they
+// expire, or until any of its reference fields change. Reference fields are
+// fields of the managed resource's spec whose names end in Ref, Refs, or
+// Selector. Only the fields of the spec that the wrapped ReferenceResolver
+// changes are cached; its changes to metadata and status, for example those
+// made by the API server when the managed resource is updated, are not.
+type CachingReferenceResolver struct {
+	inner ReferenceResolver
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]resolvedReferences
+}
+
+// NewCachingReferenceResolver returns a ReferenceResolver that caches the
+// values resolved by the supplied ReferenceResolver for the supplied TTL.
+func NewCachingReferenceResolver(inner ReferenceResolver, ttl time.Duration) *CachingReferenceResolver {
+	return &CachingReferenceResolver{
+		inner: inner,
+		ttl:   ttl,
+		now:   time.Now,
+		cache: map[string]resolvedReferences{},
+	}
+}
+
+// ResolveReferences of the supplied managed resource. Cached values are used
+// if they haven't expired and the managed resource's reference fields haven't
+// changed since they were resolved. Otherwise references are resolved by the
+// wrapped ReferenceResolver, and the values it resolves are cached.
+func (r *CachingReferenceResolver) ResolveReferences(ctx context.Context, mg Managed) error {
+	before, err := json.Marshal(mg)
+	if err != nil {
+		return errors.Wrap(err, errMarshalManaged)
+	}
+	refs, err := referenceFieldsHash(before)
+	if err != nil {
+		return err
+	}
+	key := string(mg.GetUID()) + "/" + mg.GetNamespace() + "/" + mg.GetName()
+
+	r.mu.Lock()
+	cached, ok := r.cache[key]
+	r.mu.Unlock()
+
+	if ok && cached.refs == refs && r.now().Before(cached.expires) {
+		return errors.Wrap(applyResolved(mg, before, cached.patch), errApplyResolved)
+	}
+
+	if err := r.inner.ResolveReferences(ctx, mg); err != nil {
+		r.mu.Lock()
+		delete(r.cache, key)
+		r.mu.Unlock()
+		return err
+	}
+
+	after, err := json.Marshal(mg)
+	if err != nil {
+		return errors.Wrap(err, errMarshalManaged)
+	}
+	patch, err := resolvedSpecPatch(before, after)
+	if err != nil {
+		return errors.Wrap(err, errComputeResolved)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	for k, v := range r.cache {
+		if !now.Before(v.expires) {
+			delete(r.cache, k)
+		}
+	}
+	r.cache[key] = resolvedReferences{refs: refs, patch: patch, expires: now.Add(r.ttl)}
+	return nil
+}
+
+// resolvedSpecPatch returns a JSON merge patch of the changes made to the
+// non-reference fields of the spec of the supplied JSON serialized managed
+// resource, i.e. the resolved values. Changes to metadata and status aren't
+// included, because values like the resource version would be stale by the
+// time the patch was applied.
+func resolvedSpecPatch(before, after []byte) ([]byte, error) {
+	b, err := specOf(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := specOf(after)
+	if err != nil {
+		return nil, err
+	}
+	j, err := jsonpatch.CreateMergePatch(b, a)
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]any{}
+	if err := json.Unmarshal(j, &spec); err != nil {
+		return nil, err
+	}
+	withoutReferenceFields(spec)
+	return json.Marshal(map[string]any{"spec": spec})
+}
+
+// specOf returns the JSON serialized spec of the supplied JSON serialized
+// managed resource.
+func specOf(j []byte) ([]byte, error) {
+	obj := map[string]json.RawMessage{}
+	if err := json.Unmarshal(j, &obj); err != nil {
+		return nil, err
+	}
+	if spec, ok := obj["spec"]; ok {
+		return spec, nil
+	}
+	return []byte("{}"), nil
+}
+
+// withoutReferenceFields removes reference fields from the supplied merge
+// patch. Reference fields are known to be unchanged when the patch is applied,
+// so only the values resolved from them need be patched. Arrays are replaced
+// wholesale by a merge patch, so their elements are left as is.
+func withoutReferenceFields(patch map[string]any) {
+	for k, v := range patch {
+		if isReferenceOrSelectorField(k) {
+			delete(patch, k)
+			continue
+		}
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		withoutReferenceFields(m)
+		if len(m) == 0 {
+			delete(patch, k)
+		}
+	}
+}
+
+// applyResolved applies the supplied merge patch of resolved values to the
+// supplied managed resource, whose current JSON serialization is supplied.
+func applyResolved(mg Managed, current, patch []byte) error {
+	j, err := jsonpatch.MergePatch(current, patch)
+	if err != nil {
+		return err
+	}
+	// Unmarshal into a fresh, zero managed resource so that fields the patch
+	// removes are removed rather than left unchanged. We only overwrite the
+	// supplied managed resource once we know unmarshalling succeeded.
+	v := reflect.ValueOf(mg).Elem()
+	fresh := reflect.New(v.Type())
+	if err := json.Unmarshal(j, fresh.Interface()); err != nil {
+		return errors.Wrap(err, errUnmarshalManaged)
+	}
+	v.Set(fresh.Elem())
+	return nil
+}
+
+// referenceFieldsHash returns a hash of the reference fields of the spec of the
+// supplied JSON serialized managed resource.
+func referenceFieldsHash(j []byte) (string, error) {
+	obj := map[string]any{}
+	if err := json.Unmarshal(j, &obj); err != nil {
+		return "", errors.Wrap(err, errUnmarshalManaged)
+	}
+	refs := map[string]any{}
+	WalkReferenceFields(obj["spec"], func(path, _ string, value any) {
+		refs[path] = value
+	})
+	// JSON encoding of maps sorts their keys, so equal fields always hash
+	// equally.
+	b, err := json.Marshal(refs)
+	if err != nil {
+		return "", errors.Wrap(err, errMarshalManaged)
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
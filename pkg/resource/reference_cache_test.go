@@ -0,0 +1,300 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type referencingSpec struct {
+	Subnet    string          `json:"subnet,omitempty"`
+	SubnetRef *xpv1.Reference `json:"subnetRef,omitempty"`
+}
+
+type referencingManaged struct {
+	fake.Managed
+
+	Spec referencingSpec `json:"spec"`
+}
+
+func TestCachingReferenceResolver(t *testing.T) {
+	errBoom := errors.New("boom")
+	ttl := 1 * time.Minute
+
+	mg := func(ref string) *referencingManaged {
+		return &referencingManaged{
+			Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: types.UID("cool-uid")}},
+			Spec:    referencingSpec{SubnetRef: &xpv1.Reference{Name: ref}},
+		}
+	}
+
+	type args struct {
+		first   *referencingManaged
+		second  *referencingManaged
+		elapsed time.Duration
+		errs    []error
+	}
+	type want struct {
+		calls  int
+		err    error
+		subnet string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"WithinTTL": {
+			reason: "Within the TTL the wrapped resolver shouldn't be called again, and cached values should be used.",
+			args: args{
+				first:   mg("a"),
+				second:  mg("a"),
+				elapsed: ttl / 2,
+			},
+			want: want{
+				calls:  1,
+				subnet: "resolved-a",
+			},
+		},
+		"AfterExpiry": {
+			reason: "After the TTL expires the wrapped resolver should be called again.",
+			args: args{
+				first:   mg("a"),
+				second:  mg("a"),
+				elapsed: ttl,
+			},
+			want: want{
+				calls:  2,
+				subnet: "resolved-a",
+			},
+		},
+		"ReferenceChanged": {
+			reason: "Changing a reference field should invalidate cached values, even within the TTL.",
+			args: args{
+				first:   mg("a"),
+				second:  mg("b"),
+				elapsed: ttl / 2,
+			},
+			want: want{
+				calls:  2,
+				subnet: "resolved-b",
+			},
+		},
+		"ResolveError": {
+			reason: "Errors resolving references shouldn't be cached.",
+			args: args{
+				first:   mg("a"),
+				second:  mg("a"),
+				elapsed: ttl / 2,
+				errs:    []error{errBoom, errBoom},
+			},
+			want: want{
+				calls: 2,
+				err:   errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			inner := ReferenceResolverFn(func(_ context.Context, mg Managed) error {
+				defer func() { got.calls++ }()
+				if got.calls < len(tc.args.errs) {
+					return tc.args.errs[got.calls]
+				}
+				rm := mg.(*referencingManaged)
+				rm.Spec.Subnet = "resolved-" + rm.Spec.SubnetRef.Name
+				return nil
+			})
+
+			now := time.Now()
+			r := NewCachingReferenceResolver(inner, ttl)
+			r.now = func() time.Time { return now }
+
+			_ = r.ResolveReferences(context.Background(), tc.args.first)
+			now = now.Add(tc.args.elapsed)
+			got.err = r.ResolveReferences(context.Background(), tc.args.second)
+			got.subnet = tc.args.second.Spec.Subnet
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.ResolveReferences(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCachingReferenceResolverServerSetFields(t *testing.T) {
+	mg := func(rv string, c xpv1.Condition) *referencingManaged {
+		m := &referencingManaged{
+			Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: types.UID("cool-uid"), ResourceVersion: rv}},
+			Spec:    referencingSpec{SubnetRef: &xpv1.Reference{Name: "a"}},
+		}
+		m.SetConditions(c)
+		return m
+	}
+
+	// The wrapped resolver persists the resolved values, so the API server
+	// sets the managed resource's resource version and status.
+	inner := ReferenceResolverFn(func(_ context.Context, mg Managed) error {
+		rm := mg.(*referencingManaged)
+		rm.Spec.Subnet = "resolved-" + rm.Spec.SubnetRef.Name
+		rm.SetResourceVersion("6")
+		rm.SetConditions(xpv1.Available())
+		return nil
+	})
+
+	r := NewCachingReferenceResolver(inner, 1*time.Minute)
+	if err := r.ResolveReferences(context.Background(), mg("5", xpv1.Creating())); err != nil {
+		t.Fatalf("r.ResolveReferences(...): %s", err)
+	}
+
+	got := mg("9", xpv1.Unavailable())
+	if err := r.ResolveReferences(context.Background(), got); err != nil {
+		t.Fatalf("r.ResolveReferences(...): %s", err)
+	}
+
+	want := mg("9", xpv1.Unavailable())
+	want.Spec.Subnet = "resolved-a"
+	if diff := cmp.Diff(want, got, test.EquateConditions()); diff != "" {
+		t.Errorf("\nCached values should only be applied to the spec, not to server set metadata or status.\nr.ResolveReferences(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestApplyResolved(t *testing.T) {
+	mg := func(subnet string) *referencingManaged {
+		return &referencingManaged{
+			Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool"}},
+			Spec:    referencingSpec{Subnet: subnet, SubnetRef: &xpv1.Reference{Name: "cool-subnet"}},
+		}
+	}
+
+	type args struct {
+		mg      *referencingManaged
+		current string
+		patch   string
+	}
+	type want struct {
+		mg  *referencingManaged
+		err bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Applied": {
+			reason: "The resolved values should be applied to the managed resource.",
+			args: args{
+				mg:      mg(""),
+				current: `{"name":"cool","spec":{"subnetRef":{"name":"cool-subnet"}}}`,
+				patch:   `{"spec":{"subnet":"resolved"}}`,
+			},
+			want: want{
+				mg: mg("resolved"),
+			},
+		},
+		"RemovedField": {
+			reason: "Fields the patch removes should be removed from the managed resource.",
+			args: args{
+				mg:      mg("stale"),
+				current: `{"name":"cool","spec":{"subnet":"stale","subnetRef":{"name":"cool-subnet"}}}`,
+				patch:   `{"spec":{"subnet":null}}`,
+			},
+			want: want{
+				mg: mg(""),
+			},
+		},
+		"UnmarshalError": {
+			reason: "The managed resource should be left unchanged if the patched resource can't be unmarshalled.",
+			args: args{
+				mg:      mg("original"),
+				current: `{"name":"cool","spec":{"subnet":"original","subnetRef":{"name":"cool-subnet"}}}`,
+				patch:   `{"spec":{"subnet":42}}`,
+			},
+			want: want{
+				mg:  mg("original"),
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := applyResolved(tc.args.mg, []byte(tc.args.current), []byte(tc.args.patch))
+			if gotErr := err != nil; gotErr != tc.want.err {
+				t.Errorf("\n%s\napplyResolved(...): want error %t, got %v", tc.reason, tc.want.err, err)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("\n%s\napplyResolved(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResolvedSpecPatch(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		before string
+		after  string
+		want   string
+	}{
+		"ResolvedValues": {
+			reason: "Non-reference fields of the spec that were changed should be patched.",
+			before: `{"spec":{"forProvider":{"subnetRef":{"name":"a"}}}}`,
+			after:  `{"spec":{"forProvider":{"subnet":"resolved-a","subnetRef":{"name":"a"}}}}`,
+			want:   `{"spec":{"forProvider":{"subnet":"resolved-a"}}}`,
+		},
+		"ReferenceFields": {
+			reason: "Changes to reference and selector fields shouldn't be patched.",
+			before: `{"spec":{"forProvider":{"subnetSelector":{"matchLabels":{"cool":"true"}}}}}`,
+			after:  `{"spec":{"forProvider":{"subnet":"resolved-a","subnetRef":{"name":"a"},"subnetSelector":{"matchLabels":{"cool":"true"}}}}}`,
+			want:   `{"spec":{"forProvider":{"subnet":"resolved-a"}}}`,
+		},
+		"MetadataAndStatus": {
+			reason: "Changes to metadata and status shouldn't be patched.",
+			before: `{"metadata":{"resourceVersion":"5"},"spec":{"subnetRef":{"name":"a"}}}`,
+			after:  `{"metadata":{"resourceVersion":"6"},"spec":{"subnetRef":{"name":"a"}},"status":{"cool":true}}`,
+			want:   `{"spec":{}}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolvedSpecPatch([]byte(tc.before), []byte(tc.after))
+			if err != nil {
+				t.Fatalf("\n%s\nresolvedSpecPatch(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("\n%s\nresolvedSpecPatch(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
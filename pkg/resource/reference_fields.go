@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"strconv"
+	"strings"
+)
+
+// WalkReferenceFields calls the supplied function for each field of the
+// supplied JSON value that references or selects other resources, i.e. each
+// field whose name ends in Ref, Refs, or Selector. The function is passed the
+// field's path, its name, and its value. Paths are relative to the supplied
+// value, for example .forProvider.subnetRef or .forProvider.rules[0].vpcRef.
+// The values of reference fields aren't walked.
+func WalkReferenceFields(v any, fn func(path, field string, value any)) {
+	walkReferenceFields("", v, fn)
+}
+
+func walkReferenceFields(path string, v any, fn func(path, field string, value any)) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, fv := range t {
+			p := path + "." + k
+			if isReferenceOrSelectorField(k) {
+				fn(p, k, fv)
+				continue
+			}
+			walkReferenceFields(p, fv, fn)
+		}
+	case []any:
+		for i, e := range t {
+			walkReferenceFields(path+"["+strconv.Itoa(i)+"]", e, fn)
+		}
+	}
+}
+
+// ReferenceNames returns the names of the resources referenced by the supplied
+// value of a Ref or Refs field.
+func ReferenceNames(value any) []string {
+	switch t := value.(type) {
+	case map[string]any:
+		if n, ok := t["name"].(string); ok && n != "" {
+			return []string{n}
+		}
+	case []any:
+		var names []string
+		for _, ref := range t {
+			names = append(names, ReferenceNames(ref)...)
+		}
+		return names
+	}
+	return nil
+}
+
+// isReferenceOrSelectorField returns true if the supplied field name is that
+// of a field that references or selects other resources.
+func isReferenceOrSelectorField(field string) bool {
+	return IsReferenceField(field) || strings.HasSuffix(field, "Selector")
+}
+
+// IsReferenceField returns true if the supplied field name is that of a field
+// that references other resources, i.e. one whose name ends in Ref or Refs.
+func IsReferenceField(field string) bool {
+	return strings.HasSuffix(field, "Ref") || strings.HasSuffix(field, "Refs")
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWalkReferenceFields(t *testing.T) {
+	spec := map[string]any{
+		"forProvider": map[string]any{
+			"region":         "us-east-1",
+			"subnetIdRef":    map[string]any{"name": "a"},
+			"subnetSelector": map[string]any{"matchLabels": map[string]any{"cool": "true"}},
+			"rules": []any{
+				map[string]any{"vpcRefs": []any{map[string]any{"name": "b"}, map[string]any{"name": "c"}}},
+			},
+		},
+	}
+
+	type field struct {
+		Field string
+		Names []string
+	}
+	got := map[string]field{}
+	WalkReferenceFields(spec, func(path, f string, value any) {
+		got[path] = field{Field: f, Names: ReferenceNames(value)}
+	})
+
+	want := map[string]field{
+		".forProvider.subnetIdRef":      {Field: "subnetIdRef", Names: []string{"a"}},
+		".forProvider.subnetSelector":   {Field: "subnetSelector"},
+		".forProvider.rules[0].vpcRefs": {Field: "vpcRefs", Names: []string{"b", "c"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("WalkReferenceFields(...): -want, +got:\n%s", diff)
+	}
+}
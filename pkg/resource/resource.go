@@ -37,6 +37,7 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/unstructured"
 )
@@ -54,6 +55,12 @@ const (
 	errMarshalJSON            = "cannot marshal to JSON"
 	errUnmarshalJSON          = "cannot unmarshal JSON data"
 	errStructFromUnstructured = "cannot create Struct"
+	errFmtNewObject           = "cannot create object of kind %s"
+	errFmtNotObject           = "kind %s is not an object"
+	errPaveCurrent            = "cannot pave current object"
+	errPaveDesired            = "cannot pave desired object"
+	errFmtPreservePath        = "cannot preserve field path %q"
+	errConvertDesired         = "cannot convert desired object from unstructured data"
 )
 
 // A ManagedKind contains the type metadata for a kind of managed resource.
@@ -121,16 +128,38 @@ func ConnectionSecretFor(o ConnectionSecretOwner, kind schema.GroupVersionKind)
 	}
 }
 
+// NewObjectForGVK returns a new Object of the supplied kind. It returns an
+// error if the kind is unknown to the supplied ObjectCreator, or if the kind is
+// not an Object (for example if it is a list kind).
+func NewObjectForGVK(kind schema.GroupVersionKind, oc runtime.ObjectCreater) (client.Object, error) {
+	obj, err := newObject(kind, oc)
+	if err != nil {
+		return nil, err
+	}
+	co, ok := obj.(client.Object)
+	if !ok {
+		return nil, errors.Errorf(errFmtNotObject, kind)
+	}
+	return co, nil
+}
+
 // MustCreateObject returns a new Object of the supplied kind. It panics if the
 // kind is unknown to the supplied ObjectCreator.
 func MustCreateObject(kind schema.GroupVersionKind, oc runtime.ObjectCreater) runtime.Object {
-	obj, err := oc.New(kind)
+	obj, err := newObject(kind, oc)
 	if err != nil {
 		panic(err)
 	}
 	return obj
 }
 
+// newObject is shared by NewObjectForGVK and MustCreateObject. Unlike the
+// former it supports kinds that are not Objects, such as lists.
+func newObject(kind schema.GroupVersionKind, oc runtime.ObjectCreater) (runtime.Object, error) {
+	obj, err := oc.New(kind)
+	return obj, errors.Wrapf(err, errFmtNewObject, kind)
+}
+
 // GetKind returns the GroupVersionKind of the supplied object. It return an
 // error if the object is unknown to the supplied ObjectTyper, the object is
 // unversioned, or the object does not have exactly one registered kind.
@@ -173,7 +202,8 @@ func Ignore(is ErrorIs, err error) error {
 
 // IgnoreAny ignores errors that satisfy any of the supplied ErrorIs functions
 // by returning nil. Errors that do not satisfy any of the supplied functions
-// are returned unmodified.
+// are returned unmodified. The classification functions in package errors may
+// be composed, e.g. IgnoreAny(err, errors.IsNotFound, errors.IsConflict).
 func IgnoreAny(err error, is ...ErrorIs) error {
 	for _, f := range is {
 		if f(err) {
@@ -205,6 +235,53 @@ func IsConditionTrue(c xpv1.Condition) bool {
 	return c.Status == corev1.ConditionTrue
 }
 
+// ReplaceStaleOwnerReferences replaces the UID of any owner reference of the
+// supplied object that refers to an owner of the same group, kind, and name as
+// the supplied owner reference, but with a different UID. Owner references go
+// stale when an owner is deleted and recreated with the same name while its
+// dependent survives, and may cause the dependent to be garbage collected. It
+// returns true if any owner references were replaced.
+func ReplaceStaleOwnerReferences(o metav1.Object, owner metav1.OwnerReference) bool {
+	group := func(apiVersion string) string {
+		gv, _ := schema.ParseGroupVersion(apiVersion)
+		return gv.Group
+	}
+
+	refs := o.GetOwnerReferences()
+	replaced := false
+	for i := range refs {
+		r := &refs[i]
+		if r.UID == owner.UID || r.Kind != owner.Kind || r.Name != owner.Name || group(r.APIVersion) != group(owner.APIVersion) {
+			continue
+		}
+		r.APIVersion = owner.APIVersion
+		r.UID = owner.UID
+		replaced = true
+	}
+	if replaced {
+		o.SetOwnerReferences(refs)
+	}
+	return replaced
+}
+
+// A ConditionedObject is an object with conditions.
+type ConditionedObject interface {
+	metav1.Object
+	Conditioned
+}
+
+// SetConditionsWithGeneration sets the supplied conditions on the supplied
+// object, with their observed generation set to the object's current
+// generation.
+func SetConditionsWithGeneration(o ConditionedObject, c ...xpv1.Condition) {
+	gen := o.GetGeneration()
+	stamped := make([]xpv1.Condition, len(c))
+	for i := range c {
+		stamped[i] = c[i].WithObservedGeneration(gen)
+	}
+	o.SetConditions(stamped...)
+}
+
 // An Applicator applies changes to an object.
 type Applicator interface {
 	Apply(ctx context.Context, obj client.Object, o ...ApplyOption) error
@@ -364,6 +441,48 @@ func IsNotAllowed(err error) bool {
 	return ok
 }
 
+// ErrTerminal is a sentinel error that indicates an operation failed
+// terminally. Errors returned by Terminal match ErrTerminal per errors.Is, and
+// errors that wrap ErrTerminal satisfy IsTerminal.
+var ErrTerminal = errors.New("terminal error")
+
+type errTerminal struct{ error }
+
+func (e errTerminal) Terminal() bool {
+	return true
+}
+
+func (e errTerminal) Is(target error) bool {
+	return target == ErrTerminal //nolint:errorlint // We're implementing errors.Is.
+}
+
+func (e errTerminal) Unwrap() error {
+	return e.error
+}
+
+// Terminal wraps the supplied error to indicate that it is terminal, i.e. that
+// retrying the operation that produced it will not succeed until something
+// (typically the resource's spec) changes. Terminal returns nil if the supplied
+// error is nil.
+func Terminal(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errTerminal{error: err}
+}
+
+// IsTerminal returns true if the supplied error, or any error it wraps,
+// indicates that an operation failed terminally.
+func IsTerminal(err error) bool {
+	if errors.Is(err, ErrTerminal) {
+		return true
+	}
+	var t interface {
+		Terminal() bool
+	}
+	return errors.As(err, &t) && t.Terminal()
+}
+
 // AllowUpdateIf will only update the current object if the supplied fn returns
 // true. An error that satisfies IsNotAllowed will be returned if the supplied
 // function returns false. Creation of a desired object that does not currently
@@ -391,6 +510,62 @@ func StoreCurrentRV(origRV *string) ApplyOption {
 	}
 }
 
+// PreservePaths copies the fields at the supplied paths from the current object
+// to the desired object before it's applied. This prevents an apply from
+// clearing fields that were set by the API server, a mutating webhook, or
+// another controller, for example defaulted fields the desired object doesn't
+// specify. Paths may contain wildcards. Fields that aren't set in the current
+// object are left unchanged in the desired object.
+func PreservePaths(paths ...string) ApplyOption {
+	return func(_ context.Context, current, desired runtime.Object) error {
+		c, err := fieldpath.PaveObject(current)
+		if err != nil {
+			return errors.Wrap(err, errPaveCurrent)
+		}
+		d, err := fieldpath.PaveObject(desired)
+		if err != nil {
+			return errors.Wrap(err, errPaveDesired)
+		}
+		for _, path := range paths {
+			expanded, err := c.ExpandWildcards(path)
+			if err != nil && !fieldpath.IsNotFound(err) {
+				return errors.Wrapf(err, errFmtPreservePath, path)
+			}
+			for _, e := range expanded {
+				v, err := c.GetValue(e)
+				if err != nil {
+					return errors.Wrapf(err, errFmtPreservePath, e)
+				}
+				if err := d.SetValue(e, v); err != nil {
+					return errors.Wrapf(err, errFmtPreservePath, e)
+				}
+			}
+		}
+		return errors.Wrap(runtime.DefaultUnstructuredConverter.FromUnstructured(d.UnstructuredContent(), desired), errConvertDesired)
+	}
+}
+
+// GetExternalName returns the external name of the supplied object. The
+// external name is read using the object's ExternalNameAccessor methods if it
+// satisfies that interface, and from its external name annotation otherwise.
+func GetExternalName(o metav1.Object) string {
+	if a, ok := o.(ExternalNameAccessor); ok {
+		return a.GetExternalName()
+	}
+	return meta.GetExternalName(o)
+}
+
+// SetExternalName sets the external name of the supplied object. The external
+// name is written using the object's ExternalNameAccessor methods if it
+// satisfies that interface, and to its external name annotation otherwise.
+func SetExternalName(o metav1.Object, name string) {
+	if a, ok := o.(ExternalNameAccessor); ok {
+		a.SetExternalName(name)
+		return
+	}
+	meta.SetExternalName(o, name)
+}
+
 // GetExternalTags returns the identifying tags to be used to tag the external
 // resource in provider API.
 func GetExternalTags(mg Managed) map[string]string {
@@ -30,10 +30,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 )
@@ -278,6 +280,67 @@ func TestMustCreateObject(t *testing.T) {
 	}
 }
 
+func TestNewObjectForGVK(t *testing.T) {
+	_, errNotRegistered := runtime.NewScheme().New(fake.GVK(&fake.Managed{}))
+
+	type args struct {
+		kind schema.GroupVersionKind
+		oc   runtime.ObjectCreater
+	}
+	type want struct {
+		obj client.Object
+		err error
+	}
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"KindRegistered": {
+			reason: "We should return a new object of a kind that is registered with the scheme.",
+			args: args{
+				kind: fake.GVK(&fake.Managed{}),
+				oc:   fake.SchemeWith(&fake.Managed{}),
+			},
+			want: want{obj: &fake.Managed{}},
+		},
+		"KindNotRegistered": {
+			reason: "We should return an error, rather than panic, if the kind is not registered with the scheme.",
+			args: args{
+				kind: fake.GVK(&fake.Managed{}),
+				oc:   runtime.NewScheme(),
+			},
+			want: want{err: errors.Wrapf(errNotRegistered, errFmtNewObject, fake.GVK(&fake.Managed{}))},
+		},
+		"KindNotObject": {
+			reason: "We should return an error if the kind is not an object.",
+			args: args{
+				kind: fake.GVK(&fake.Managed{}),
+				oc:   objectCreaterFn(func(_ schema.GroupVersionKind) (runtime.Object, error) { return &metav1.List{}, nil }),
+			},
+			want: want{err: errors.Errorf(errFmtNotObject, fake.GVK(&fake.Managed{}))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewObjectForGVK(tc.args.kind, tc.args.oc)
+			if diff := cmp.Diff(tc.want.obj, got); diff != "" {
+				t.Errorf("\n%s\nNewObjectForGVK(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nNewObjectForGVK(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type objectCreaterFn func(kind schema.GroupVersionKind) (runtime.Object, error)
+
+func (fn objectCreaterFn) New(kind schema.GroupVersionKind) (runtime.Object, error) {
+	return fn(kind)
+}
+
 func TestIgnore(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -350,6 +413,34 @@ func TestIgnoreAny(t *testing.T) {
 			},
 			want: errBoom,
 		},
+		"IgnoreNotFound": {
+			args: args{
+				is:  []ErrorIs{errors.IsNotFound, errors.IsConflict},
+				err: kerrors.NewNotFound(schema.GroupResource{}, "cool"),
+			},
+			want: nil,
+		},
+		"IgnoreConflict": {
+			args: args{
+				is:  []ErrorIs{errors.IsNotFound, errors.IsConflict},
+				err: kerrors.NewConflict(schema.GroupResource{}, "cool", errBoom),
+			},
+			want: nil,
+		},
+		"PropagateOtherErrors": {
+			args: args{
+				is:  []ErrorIs{errors.IsNotFound, errors.IsConflict},
+				err: kerrors.NewForbidden(schema.GroupResource{}, "cool", errBoom),
+			},
+			want: kerrors.NewForbidden(schema.GroupResource{}, "cool", errBoom),
+		},
+		"NoError": {
+			args: args{
+				is:  []ErrorIs{errors.IsNotFound, errors.IsConflict},
+				err: nil,
+			},
+			want: nil,
+		},
 	}
 
 	for name, tc := range cases {
@@ -395,6 +486,102 @@ func TestIsConditionTrue(t *testing.T) {
 	}
 }
 
+func TestReplaceStaleOwnerReferences(t *testing.T) {
+	owner := metav1.OwnerReference{APIVersion: "example.org/v1", Kind: "Cool", Name: "cool", UID: types.UID("new-uid")}
+	other := metav1.OwnerReference{APIVersion: "example.org/v1", Kind: "Cool", Name: "other", UID: types.UID("other-uid")}
+
+	type want struct {
+		replaced bool
+		refs     []metav1.OwnerReference
+	}
+
+	cases := map[string]struct {
+		reason string
+		refs   []metav1.OwnerReference
+		want   want
+	}{
+		"Stale": {
+			reason: "An owner reference to the same owner with a different UID should be replaced, preserving whether it's a controller reference.",
+			refs: []metav1.OwnerReference{
+				{APIVersion: "example.org/v1", Kind: "Cool", Name: "cool", UID: types.UID("old-uid"), Controller: ptr.To(true)},
+				other,
+			},
+			want: want{
+				replaced: true,
+				refs: []metav1.OwnerReference{
+					{APIVersion: "example.org/v1", Kind: "Cool", Name: "cool", UID: types.UID("new-uid"), Controller: ptr.To(true)},
+					other,
+				},
+			},
+		},
+		"StaleVersion": {
+			reason: "An owner reference to the same owner at a different version should be replaced.",
+			refs:   []metav1.OwnerReference{{APIVersion: "example.org/v1beta1", Kind: "Cool", Name: "cool", UID: types.UID("old-uid")}},
+			want: want{
+				replaced: true,
+				refs:     []metav1.OwnerReference{{APIVersion: "example.org/v1", Kind: "Cool", Name: "cool", UID: types.UID("new-uid")}},
+			},
+		},
+		"Current": {
+			reason: "An owner reference to the current owner shouldn't be replaced.",
+			refs:   []metav1.OwnerReference{owner},
+			want:   want{refs: []metav1.OwnerReference{owner}},
+		},
+		"OtherGroup": {
+			reason: "An owner reference to an owner of a different group shouldn't be replaced.",
+			refs:   []metav1.OwnerReference{{APIVersion: "other.org/v1", Kind: "Cool", Name: "cool", UID: types.UID("old-uid")}},
+			want:   want{refs: []metav1.OwnerReference{{APIVersion: "other.org/v1", Kind: "Cool", Name: "cool", UID: types.UID("old-uid")}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{OwnerReferences: tc.refs}}
+			got := want{replaced: ReplaceStaleOwnerReferences(o, owner), refs: o.GetOwnerReferences()}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nReplaceStaleOwnerReferences(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetConditionsWithGeneration(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      ConditionedObject
+		c      []xpv1.Condition
+		want   []xpv1.Condition
+	}{
+		"Stamped": {
+			reason: "Conditions should be set with the object's current generation as their observed generation.",
+			o:      &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 3}},
+			c:      []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()},
+			want:   []xpv1.Condition{xpv1.Available().WithObservedGeneration(3), xpv1.ReconcileSuccess().WithObservedGeneration(3)},
+		},
+		"Restamped": {
+			reason: "A condition's existing observed generation should be replaced with the object's current generation.",
+			o:      &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 4}},
+			c:      []xpv1.Condition{xpv1.Available().WithObservedGeneration(2)},
+			want:   []xpv1.Condition{xpv1.Available().WithObservedGeneration(4)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetConditionsWithGeneration(tc.o, tc.c...)
+			for _, want := range tc.want {
+				got := tc.o.GetCondition(want.Type)
+				if diff := cmp.Diff(want, got, test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nSetConditionsWithGeneration(...): -want, +got:\n%s", tc.reason, diff)
+				}
+				if got.ObservedGeneration != want.ObservedGeneration {
+					t.Errorf("\n%s\nSetConditionsWithGeneration(...): want observed generation %d, got %d", tc.reason, want.ObservedGeneration, got.ObservedGeneration)
+				}
+			}
+		})
+	}
+}
+
 type object struct {
 	runtime.Object
 	metav1.ObjectMeta
@@ -437,6 +624,63 @@ func TestIsNotControllable(t *testing.T) {
 	}
 }
 
+func TestIsTerminal(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   bool
+	}{
+		"NilError": {
+			reason: "A nil error is not terminal.",
+			err:    nil,
+			want:   false,
+		},
+		"UnknownError": {
+			reason: "An error that doesn't have a 'Terminal() bool' method is not terminal.",
+			err:    errors.New("boom"),
+			want:   false,
+		},
+		"TerminalError": {
+			reason: "An error returned by Terminal is terminal.",
+			err:    Terminal(errors.New("boom")),
+			want:   true,
+		},
+		"WrappedTerminalError": {
+			reason: "An error that wraps a terminal error is terminal.",
+			err:    errors.Wrap(Terminal(errors.New("boom")), "wrapped"),
+			want:   true,
+		},
+		"WrappedSentinelError": {
+			reason: "An error that wraps ErrTerminal is terminal.",
+			err:    errors.Wrap(ErrTerminal, "boom"),
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTerminal(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIsTerminal(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTerminal(t *testing.T) {
+	if Terminal(nil) != nil {
+		t.Errorf("Terminal(nil): want nil error")
+	}
+
+	errBoom := errors.New("boom")
+	if !errors.Is(Terminal(errBoom), errBoom) {
+		t.Errorf("errors.Is(Terminal(errBoom), errBoom): false")
+	}
+	if !errors.Is(Terminal(errBoom), ErrTerminal) {
+		t.Errorf("errors.Is(Terminal(errBoom), ErrTerminal): false")
+	}
+}
+
 func TestMustBeControllableBy(t *testing.T) {
 	uid := types.UID("very-unique-string")
 	controller := true
@@ -609,6 +853,92 @@ func TestAllowUpdateIf(t *testing.T) {
 	}
 }
 
+func TestPreservePaths(t *testing.T) {
+	cm := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}, Data: data}
+	}
+
+	type args struct {
+		current *corev1.ConfigMap
+		desired *corev1.ConfigMap
+		paths   []string
+	}
+	type want struct {
+		applied *corev1.ConfigMap
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Preserved": {
+			reason: "A server-set field should survive an apply that doesn't specify it.",
+			args: args{
+				current: cm(map[string]string{"defaulted": "server", "cool": "old"}),
+				desired: cm(map[string]string{"cool": "new"}),
+				paths:   []string{"data.defaulted"},
+			},
+			want: want{
+				applied: cm(map[string]string{"defaulted": "server", "cool": "new"}),
+			},
+		},
+		"NotPreserved": {
+			reason: "Fields that aren't preserved should be cleared by an apply that doesn't specify them.",
+			args: args{
+				current: cm(map[string]string{"defaulted": "server", "cool": "old"}),
+				desired: cm(map[string]string{"cool": "new"}),
+			},
+			want: want{
+				applied: cm(map[string]string{"cool": "new"}),
+			},
+		},
+		"NotSetInCurrent": {
+			reason: "A preserved path that isn't set in the current object should leave the desired object unchanged.",
+			args: args{
+				current: cm(map[string]string{"cool": "old"}),
+				desired: cm(map[string]string{"cool": "new"}),
+				paths:   []string{"data.defaulted"},
+			},
+			want: want{
+				applied: cm(map[string]string{"cool": "new"}),
+			},
+		},
+		"Wildcard": {
+			reason: "Preserved paths may contain wildcards.",
+			args: args{
+				current: cm(map[string]string{"a": "server", "b": "server"}),
+				desired: cm(map[string]string{"cool": "new"}),
+				paths:   []string{"data[*]"},
+			},
+			want: want{
+				applied: cm(map[string]string{"a": "server", "b": "server", "cool": "new"}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					tc.args.current.DeepCopyInto(obj.(*corev1.ConfigMap))
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					got.applied = obj.(*corev1.ConfigMap)
+					return nil
+				}),
+			}
+			got.err = NewAPIUpdatingApplicator(c).Apply(context.Background(), tc.args.desired, PreservePaths(tc.args.paths...))
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestGetExternalTags(t *testing.T) {
 	provName := "prov"
 	cases := map[string]struct {
@@ -641,6 +971,78 @@ func TestGetExternalTags(t *testing.T) {
 }
 
 // single test case => not using tables.
+type externalNamedManaged struct {
+	fake.Managed
+	fake.ExternalNameAccessor
+}
+
+func TestGetExternalName(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      metav1.Object
+		want   string
+	}{
+		"Annotation": {
+			reason: "The external name should be read from the annotation if the object is not an ExternalNameAccessor.",
+			o: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{meta.AnnotationKeyExternalName: "cool"},
+			}},
+			want: "cool",
+		},
+		"ExternalNameAccessor": {
+			reason: "The external name should be read using the ExternalNameAccessor, ignoring the annotation.",
+			o: &externalNamedManaged{
+				Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{meta.AnnotationKeyExternalName: "annotated"},
+				}},
+				ExternalNameAccessor: fake.ExternalNameAccessor{ExternalName: "cool"},
+			},
+			want: "cool",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetExternalName(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nGetExternalName(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetExternalName(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      metav1.Object
+		want   metav1.Object
+	}{
+		"Annotation": {
+			reason: "The external name should be written to the annotation if the object is not an ExternalNameAccessor.",
+			o:      &fake.Managed{},
+			want: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{meta.AnnotationKeyExternalName: "cool"},
+			}},
+		},
+		"ExternalNameAccessor": {
+			reason: "The external name should be written using the ExternalNameAccessor, not the annotation.",
+			o:      &externalNamedManaged{},
+			want: &externalNamedManaged{
+				ExternalNameAccessor: fake.ExternalNameAccessor{ExternalName: "cool"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetExternalName(tc.o, "cool")
+			if diff := cmp.Diff(tc.want, tc.o); diff != "" {
+				t.Errorf("\n%s\nSetExternalName(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func Test_errNotControllable_NotControllable(t *testing.T) {
 	err := errNotControllable{
 		errors.New("test-error"),
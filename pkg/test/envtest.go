@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+)
+
+const (
+	// envKubebuilderAssets is the environment variable envtest reads the
+	// directory containing its binaries from.
+	envKubebuilderAssets = "KUBEBUILDER_ASSETS"
+
+	// defaultKubebuilderAssets is the directory envtest reads its binaries
+	// from if envKubebuilderAssets isn't set.
+	defaultKubebuilderAssets = "/usr/local/kubebuilder/bin"
+)
+
+// Error strings. We can't use crossplane-runtime's errors package here,
+// because its tests import this package.
+const (
+	errFmtStartEnvtest = "cannot start test environment: %w"
+	errFmtStopEnvtest  = "cannot stop test environment: %w"
+	errFmtCreateClient = "cannot create client for test environment: %w"
+	errFmtNoBinaries   = "envtest binaries not found in %s; set " + envKubebuilderAssets + " to the directory containing them: %w"
+)
+
+// ErrEnvtestUnavailable is returned by an EnvtestBuilder when the binaries
+// envtest needs to run an API server aren't present.
+var ErrEnvtestUnavailable = errors.New("envtest is unavailable")
+
+// An EnvtestBuilder starts a test environment backed by a real API server and
+// etcd, using controller-runtime's envtest. It can be used to test behaviors
+// that a MockClient can't faithfully reproduce, like patches, server-side
+// apply, and conflicts.
+type EnvtestBuilder struct {
+	crdPaths []string
+	crds     []*apiextensionsv1.CustomResourceDefinition
+	scheme   *runtime.Scheme
+}
+
+// NewEnvtestBuilder returns an EnvtestBuilder that starts a test environment
+// whose client uses the client-go scheme, and that installs no CRDs.
+func NewEnvtestBuilder() *EnvtestBuilder {
+	return &EnvtestBuilder{scheme: scheme.Scheme}
+}
+
+// WithCRDPaths installs the CRDs found at the supplied paths when the test
+// environment starts. Paths may be files or directories. Starting the test
+// environment fails if any path doesn't exist.
+func (b *EnvtestBuilder) WithCRDPaths(paths ...string) *EnvtestBuilder {
+	b.crdPaths = append(b.crdPaths, paths...)
+	return b
+}
+
+// WithCRDs installs the supplied CRDs when the test environment starts.
+func (b *EnvtestBuilder) WithCRDs(crds ...*apiextensionsv1.CustomResourceDefinition) *EnvtestBuilder {
+	b.crds = append(b.crds, crds...)
+	return b
+}
+
+// WithScheme configures the scheme used by the test environment's client.
+func (b *EnvtestBuilder) WithScheme(s *runtime.Scheme) *EnvtestBuilder {
+	b.scheme = s
+	return b
+}
+
+// An Envtest is a running test environment.
+type Envtest struct {
+	// Config that can be used to connect to the test environment's API
+	// server.
+	Config *rest.Config
+
+	// Client of the test environment's API server.
+	Client client.Client
+
+	env *envtest.Environment
+}
+
+// Stop the test environment.
+func (e *Envtest) Stop() error {
+	if err := e.env.Stop(); err != nil {
+		return fmt.Errorf(errFmtStopEnvtest, err)
+	}
+	return nil
+}
+
+// Start a test environment. It returns an error that wraps
+// ErrEnvtestUnavailable if the envtest binaries aren't present. Start may be
+// called from TestMain to share one test environment between all of a
+// package's tests; callers must Stop the returned test environment.
+func (b *EnvtestBuilder) Start() (*Envtest, error) {
+	if err := envtestBinariesPresent(); err != nil {
+		return nil, err
+	}
+
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     b.crdPaths,
+		CRDs:                  b.crds,
+		ErrorIfCRDPathMissing: true,
+		Scheme:                b.scheme,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		return nil, fmt.Errorf(errFmtStartEnvtest, err)
+	}
+	c, err := client.New(cfg, client.Options{Scheme: b.scheme})
+	if err != nil {
+		_ = env.Stop()
+		return nil, fmt.Errorf(errFmtCreateClient, err)
+	}
+	return &Envtest{Config: cfg, Client: c, env: env}, nil
+}
+
+// StartT starts a test environment for the supplied test, and stops it when
+// the test and its subtests complete. The test is skipped if the envtest
+// binaries aren't present, and fails if the test environment can't start.
+func (b *EnvtestBuilder) StartT(t *testing.T) *Envtest {
+	t.Helper()
+	e, err := b.Start()
+	if errors.Is(err, ErrEnvtestUnavailable) {
+		t.Skipf("Skipping test that requires envtest: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	t.Cleanup(func() {
+		if err := e.Stop(); err != nil {
+			t.Errorf("%s", err)
+		}
+	})
+	return e
+}
+
+// envtestBinariesPresent returns an error wrapping ErrEnvtestUnavailable if the
+// binaries envtest needs to run an API server aren't present.
+func envtestBinariesPresent() error {
+	dir := os.Getenv(envKubebuilderAssets)
+	if dir == "" {
+		dir = defaultKubebuilderAssets
+	}
+	for _, bin := range []string{"etcd", "kube-apiserver"} {
+		if _, err := os.Stat(filepath.Join(dir, bin)); err != nil {
+			return fmt.Errorf(errFmtNoBinaries, dir, ErrEnvtestUnavailable)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEnvtestBuilder(t *testing.T) {
+	e := NewEnvtestBuilder().StartT(t)
+	ctx := context.Background()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cool", Namespace: "default"},
+		Data:       map[string]string{"key": "value"},
+	}
+	if err := e.Client.Create(ctx, cm); err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+
+	cm.Data["key"] = "updated"
+	if err := e.Client.Update(ctx, cm); err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+
+	got := &corev1.ConfigMap{}
+	if err := e.Client.Get(ctx, types.NamespacedName{Name: "cool", Namespace: "default"}, got); err != nil {
+		t.Fatalf("Get(...): %s", err)
+	}
+	if diff := cmp.Diff(map[string]string{"key": "updated"}, got.Data); diff != "" {
+		t.Errorf("Get(...): -want data, +got data:\n%s", diff)
+	}
+}
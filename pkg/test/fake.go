@@ -18,8 +18,11 @@ package test
 
 import (
 	"context"
+	"reflect"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -105,6 +108,54 @@ func NewMockListFn(err error, ofn ...ObjectListFn) MockListFn {
 	}
 }
 
+// NewMockListFnFromObjects returns a MockListFn that lists the supplied
+// objects. Like a real client, it only lists the objects that are of the
+// list's item type and that match the namespace and label selector of the
+// supplied list options. Other list options, like field selectors, are
+// ignored. Listed objects are deep copies of the supplied objects.
+func NewMockListFnFromObjects(objs ...client.Object) MockListFn {
+	return func(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+		lo := &client.ListOptions{}
+		lo.ApplyOptions(opts)
+
+		items := make([]runtime.Object, 0, len(objs))
+		for _, o := range objs {
+			if !isListItem(list, o) {
+				continue
+			}
+			if lo.Namespace != "" && o.GetNamespace() != lo.Namespace {
+				continue
+			}
+			if lo.LabelSelector != nil && !lo.LabelSelector.Matches(labels.Set(o.GetLabels())) {
+				continue
+			}
+			items = append(items, o.DeepCopyObject())
+		}
+		return meta.SetList(list, items)
+	}
+}
+
+// isListItem returns true if the supplied object can be an item of the
+// supplied list. Unstructured objects can be items of an unstructured list if
+// their kind is the list's kind, less its List suffix, and their API versions
+// match.
+func isListItem(list client.ObjectList, o client.Object) bool {
+	if ul, ok := list.(*unstructured.UnstructuredList); ok {
+		u, ok := o.(*unstructured.Unstructured)
+		return ok && u.GetAPIVersion() == ul.GetAPIVersion() && u.GetKind()+"List" == ul.GetKind()
+	}
+	lv := reflect.ValueOf(list)
+	if lv.Kind() != reflect.Ptr || lv.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	items := lv.Elem().FieldByName("Items")
+	if !items.IsValid() || items.Kind() != reflect.Slice {
+		return false
+	}
+	it, ot := items.Type().Elem(), reflect.TypeOf(o)
+	return ot == it || (ot.Kind() == reflect.Ptr && ot.Elem() == it)
+}
+
 // NewMockCreateFn returns a MockCreateFn that returns the supplied error.
 func NewMockCreateFn(err error, ofn ...ObjectFn) MockCreateFn {
 	return func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
@@ -165,6 +216,18 @@ func NewMockPatchFn(err error, ofn ...ObjectFn) MockPatchFn {
 	}
 }
 
+// NewMockSubResourceGetFn returns a MockSubResourceGetFn that returns the supplied error.
+func NewMockSubResourceGetFn(err error, ofn ...ObjectFn) MockSubResourceGetFn {
+	return func(_ context.Context, _, subResource client.Object, _ ...client.SubResourceGetOption) error {
+		for _, fn := range ofn {
+			if err := fn(subResource); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+}
+
 // NewMockSubResourceCreateFn returns a MockSubResourceCreateFn that returns the supplied error.
 func NewMockSubResourceCreateFn(err error, ofn ...ObjectFn) MockSubResourceCreateFn {
 	return func(_ context.Context, obj, _ client.Object, _ ...client.SubResourceCreateOption) error {
@@ -271,9 +334,15 @@ func NewMockClient() *MockClient {
 		MockUpdate:      NewMockUpdateFn(nil),
 		MockPatch:       NewMockPatchFn(nil),
 
+		MockStatusCreate: NewMockSubResourceCreateFn(nil),
 		MockStatusUpdate: NewMockSubResourceUpdateFn(nil),
 		MockStatusPatch:  NewMockSubResourcePatchFn(nil),
 
+		MockSubResourceGet:    NewMockSubResourceGetFn(nil),
+		MockSubResourceCreate: NewMockSubResourceCreateFn(nil),
+		MockSubResourceUpdate: NewMockSubResourceUpdateFn(nil),
+		MockSubResourcePatch:  NewMockSubResourcePatchFn(nil),
+
 		MockScheme:              NewMockSchemeFn(nil),
 		MockGroupVersionKindFor: NewMockGroupVersionKindForFn(nil, schema.GroupVersionKind{}),
 		MockIsObjectNamespaced:  NewMockIsObjectNamespacedFn(nil, false),
@@ -324,7 +393,9 @@ func (c *MockClient) Status() client.SubResourceWriter {
 	}
 }
 
-// SubResource is unimplemented. It panics if called.
+// SubResource returns a client for the named sub-resource. Calls to it are
+// passed to MockClient's MockSubResource functions, regardless of the named
+// sub-resource.
 func (c *MockClient) SubResource(_ string) client.SubResourceClient {
 	return &MockSubResourceClient{
 		MockGet:    c.MockSubResourceGet,
@@ -0,0 +1,242 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+func TestMockClientSubResourcePatch(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		err   error
+		patch string
+	}
+
+	cases := map[string]struct {
+		reason string
+		fn     func(ctx context.Context, c client.Client, obj client.Object, p client.Patch) error
+		c      func(got *string) *MockClient
+		want   want
+	}{
+		"StatusPatch": {
+			reason: "Status patches should be passed to MockStatusPatch.",
+			fn: func(ctx context.Context, c client.Client, obj client.Object, p client.Patch) error {
+				return c.Status().Patch(ctx, obj, p)
+			},
+			c: func(got *string) *MockClient {
+				return &MockClient{
+					MockStatusPatch: func(_ context.Context, obj client.Object, p client.Patch, _ ...client.SubResourcePatchOption) error {
+						b, err := p.Data(obj)
+						*got = string(b)
+						return err
+					},
+				}
+			},
+			want: want{
+				patch: `{"status":{"phase":"Active"}}`,
+			},
+		},
+		"SubResourcePatch": {
+			reason: "Sub-resource patches should be passed to MockSubResourcePatch.",
+			fn: func(ctx context.Context, c client.Client, obj client.Object, p client.Patch) error {
+				return c.SubResource("status").Patch(ctx, obj, p)
+			},
+			c: func(got *string) *MockClient {
+				return &MockClient{
+					MockSubResourcePatch: func(_ context.Context, obj client.Object, p client.Patch, _ ...client.SubResourcePatchOption) error {
+						b, err := p.Data(obj)
+						*got = string(b)
+						return err
+					},
+				}
+			},
+			want: want{
+				patch: `{"status":{"phase":"Active"}}`,
+			},
+		},
+		"StatusPatchError": {
+			reason: "Errors returned by MockStatusPatch should be returned.",
+			fn: func(ctx context.Context, c client.Client, obj client.Object, p client.Patch) error {
+				return c.Status().Patch(ctx, obj, p)
+			},
+			c: func(_ *string) *MockClient {
+				return &MockClient{MockStatusPatch: NewMockSubResourcePatchFn(errBoom)}
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+		"DefaultSubResourcePatch": {
+			reason: "The default MockClient should accept sub-resource patches.",
+			fn: func(ctx context.Context, c client.Client, obj client.Object, p client.Patch) error {
+				return c.SubResource("status").Patch(ctx, obj, p)
+			},
+			c: func(_ *string) *MockClient {
+				return NewMockClient()
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Simulate a reconciler that patches the status of the object it
+			// reconciles.
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+			obj := ns.DeepCopy()
+			obj.Status.Phase = corev1.NamespaceActive
+
+			got := ""
+			err := tc.fn(context.Background(), tc.c(&got), obj, client.MergeFrom(ns))
+			if diff := cmp.Diff(tc.want.err, err, EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPatch(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.patch, got); diff != "" {
+				t.Errorf("\n%s\nPatch(...): -want patch, +got patch:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMockClientSubResourceGet(t *testing.T) {
+	c := NewMockClient()
+	c.MockSubResourceGet = NewMockSubResourceGetFn(nil, func(obj client.Object) error {
+		obj.SetName("scale")
+		return nil
+	})
+
+	sub := &corev1.Secret{}
+	if err := c.SubResource("scale").Get(context.Background(), &corev1.Secret{}, sub); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(types.NamespacedName{Name: "scale"}, client.ObjectKeyFromObject(sub)); diff != "" {
+		t.Errorf("SubResource(...).Get(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestNewMockListFnFromObjects(t *testing.T) {
+	secret := func(namespace, name string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+	}
+	unstructuredObj := func(apiVersion, kind, name string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(apiVersion)
+		u.SetKind(kind)
+		u.SetName(name)
+		return u
+	}
+
+	objs := []client.Object{
+		secret("default", "a", map[string]string{"provider": "aws", "tier": "prod"}),
+		secret("default", "b", map[string]string{"provider": "aws"}),
+		secret("other", "c", map[string]string{"provider": "gcp"}),
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "d", Labels: map[string]string{"provider": "aws"}}},
+		unstructuredObj("example.org/v1", "Database", "e"),
+		unstructuredObj("example.org/v1", "Bucket", "f"),
+	}
+
+	// names returns the names of the items of the supplied list.
+	names := func(l client.ObjectList) []string {
+		items, err := meta.ExtractList(l)
+		if err != nil {
+			t.Fatalf("meta.ExtractList(...): %v", err)
+		}
+		n := make([]string, 0, len(items))
+		for _, i := range items {
+			n = append(n, i.(client.Object).GetName())
+		}
+		return n
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   client.ObjectList
+		opts   []client.ListOption
+		want   []string
+	}{
+		"AllOfType": {
+			reason: "Only objects of the list's item type should be listed when no options are supplied.",
+			list:   &corev1.SecretList{},
+			want:   []string{"a", "b", "c"},
+		},
+		"MatchingLabels": {
+			reason: "Only objects matching the supplied labels should be listed.",
+			list:   &corev1.SecretList{},
+			opts:   []client.ListOption{client.MatchingLabels{"provider": "aws"}},
+			want:   []string{"a", "b"},
+		},
+		"MatchingLabelSelector": {
+			reason: "Only objects matching the supplied label selector should be listed.",
+			list:   &corev1.SecretList{},
+			opts:   []client.ListOption{client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(labels.Set{"provider": "aws", "tier": "prod"})}},
+			want:   []string{"a"},
+		},
+		"HasLabels": {
+			reason: "Only objects with the supplied labels should be listed.",
+			list:   &corev1.SecretList{},
+			opts:   []client.ListOption{client.HasLabels{"tier"}},
+			want:   []string{"a"},
+		},
+		"InNamespace": {
+			reason: "Only objects in the supplied namespace should be listed.",
+			list:   &corev1.SecretList{},
+			opts:   []client.ListOption{client.InNamespace("other")},
+			want:   []string{"c"},
+		},
+		"NoMatches": {
+			reason: "An empty list should be returned when no objects match.",
+			list:   &corev1.SecretList{},
+			opts:   []client.ListOption{client.MatchingLabels{"provider": "azure"}},
+			want:   []string{},
+		},
+		"Unstructured": {
+			reason: "Only unstructured objects of the list's kind should be listed.",
+			list: func() client.ObjectList {
+				l := &unstructured.UnstructuredList{}
+				l.SetAPIVersion("example.org/v1")
+				l.SetKind("DatabaseList")
+				return l
+			}(),
+			want: []string{"e"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &MockClient{MockList: NewMockListFnFromObjects(objs...)}
+			if err := c.List(context.Background(), tc.list, tc.opts...); err != nil {
+				t.Fatalf("\n%s\nc.List(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, names(tc.list)); diff != "" {
+				t.Errorf("\n%s\nc.List(...): -want names, +got names:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
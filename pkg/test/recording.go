@@ -0,0 +1,247 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var _ client.Client = &RecordingClient{}
+
+// Methods recorded by a RecordingClient.
+const (
+	MethodGet               = "Get"
+	MethodList              = "List"
+	MethodCreate            = "Create"
+	MethodDelete            = "Delete"
+	MethodDeleteAllOf       = "DeleteAllOf"
+	MethodUpdate            = "Update"
+	MethodPatch             = "Patch"
+	MethodStatusCreate      = "StatusCreate"
+	MethodStatusUpdate      = "StatusUpdate"
+	MethodStatusPatch       = "StatusPatch"
+	MethodSubResourceGet    = "SubResourceGet"
+	MethodSubResourceCreate = "SubResourceCreate"
+	MethodSubResourceUpdate = "SubResourceUpdate"
+	MethodSubResourcePatch  = "SubResourcePatch"
+)
+
+// A Call records a single call made to a RecordingClient.
+type Call struct {
+	// Method that was called, for example Get or StatusUpdate.
+	Method string
+
+	// SubResource that was called, if any. This is only set for calls made
+	// via the client's SubResource method.
+	SubResource string
+
+	// GVK of the object or list the method was called with. The GVK is
+	// empty if it could not be determined.
+	GVK schema.GroupVersionKind
+
+	// Key of the object the method was called with. List and DeleteAllOf
+	// calls only record the namespace, if any.
+	Key types.NamespacedName
+}
+
+// A RecordingClientOption configures a RecordingClient.
+type RecordingClientOption func(*RecordingClient)
+
+// WithRecordingScheme configures the scheme a RecordingClient uses to
+// determine the GVK of objects that don't have their GVK set, such as most
+// typed objects.
+func WithRecordingScheme(s *runtime.Scheme) RecordingClientOption {
+	return func(c *RecordingClient) {
+		c.scheme = s
+	}
+}
+
+// A RecordingClient wraps a client.Client, typically a MockClient, and records
+// every call made to it. Tests may use the recorded calls to assert which
+// methods were called, in what order, and with which objects.
+type RecordingClient struct {
+	client.Client
+
+	scheme *runtime.Scheme
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// NewRecordingClient returns a RecordingClient that records calls before
+// passing them to the supplied client.
+func NewRecordingClient(c client.Client, o ...RecordingClientOption) *RecordingClient {
+	rc := &RecordingClient{Client: c}
+	for _, fn := range o {
+		fn(rc)
+	}
+	return rc
+}
+
+// Calls returns a copy of the calls recorded so far, in the order they were
+// made. It is safe to call concurrently with calls to the client.
+func (c *RecordingClient) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]Call, len(c.calls))
+	copy(out, c.calls)
+	return out
+}
+
+// Reset discards all recorded calls.
+func (c *RecordingClient) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = nil
+}
+
+func (c *RecordingClient) record(method, subResource string, obj runtime.Object, key types.NamespacedName) {
+	call := Call{Method: method, SubResource: subResource, GVK: c.gvkFor(obj), Key: key}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, call)
+}
+
+func (c *RecordingClient) gvkFor(obj runtime.Object) schema.GroupVersionKind {
+	if gvk := obj.GetObjectKind().GroupVersionKind(); !gvk.Empty() {
+		return gvk
+	}
+	if c.scheme == nil {
+		return schema.GroupVersionKind{}
+	}
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return schema.GroupVersionKind{}
+	}
+	return gvk
+}
+
+// Get records the call, then calls the wrapped client's Get method.
+func (c *RecordingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	c.record(MethodGet, "", obj, key)
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+// List records the call, then calls the wrapped client's List method.
+func (c *RecordingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	lo := &client.ListOptions{}
+	lo.ApplyOptions(opts)
+	c.record(MethodList, "", list, types.NamespacedName{Namespace: lo.Namespace})
+	return c.Client.List(ctx, list, opts...)
+}
+
+// Create records the call, then calls the wrapped client's Create method.
+func (c *RecordingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.record(MethodCreate, "", obj, client.ObjectKeyFromObject(obj))
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+// Delete records the call, then calls the wrapped client's Delete method.
+func (c *RecordingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	c.record(MethodDelete, "", obj, client.ObjectKeyFromObject(obj))
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+// DeleteAllOf records the call, then calls the wrapped client's DeleteAllOf
+// method.
+func (c *RecordingClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	do := &client.DeleteAllOfOptions{}
+	do.ApplyOptions(opts)
+	c.record(MethodDeleteAllOf, "", obj, types.NamespacedName{Namespace: do.Namespace})
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+// Update records the call, then calls the wrapped client's Update method.
+func (c *RecordingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	c.record(MethodUpdate, "", obj, client.ObjectKeyFromObject(obj))
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+// Patch records the call, then calls the wrapped client's Patch method.
+func (c *RecordingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	c.record(MethodPatch, "", obj, client.ObjectKeyFromObject(obj))
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+// Status returns a status writer that records calls before passing them to the
+// wrapped client's status writer.
+func (c *RecordingClient) Status() client.SubResourceWriter {
+	return &recordingSubResourceClient{
+		parent:  c,
+		wrapped: c.Client.Status(),
+		create:  MethodStatusCreate,
+		update:  MethodStatusUpdate,
+		patch:   MethodStatusPatch,
+	}
+}
+
+// SubResource returns a sub-resource client that records calls before passing
+// them to the wrapped client's sub-resource client.
+func (c *RecordingClient) SubResource(subResource string) client.SubResourceClient {
+	return &recordingSubResourceClient{
+		parent:      c,
+		wrapped:     c.Client.SubResource(subResource),
+		subResource: subResource,
+		get:         MethodSubResourceGet,
+		create:      MethodSubResourceCreate,
+		update:      MethodSubResourceUpdate,
+		patch:       MethodSubResourcePatch,
+	}
+}
+
+type recordingSubResourceClient struct {
+	parent      *RecordingClient
+	wrapped     client.SubResourceWriter
+	subResource string
+
+	get    string
+	create string
+	update string
+	patch  string
+}
+
+// Get records the call, then gets the sub-resource.
+func (c *recordingSubResourceClient) Get(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	c.parent.record(c.get, c.subResource, obj, client.ObjectKeyFromObject(obj))
+	//nolint:forcetypeassert // Only sub-resource clients, which can get, set get.
+	return c.wrapped.(client.SubResourceClient).Get(ctx, obj, subResource, opts...)
+}
+
+// Create records the call, then creates the sub-resource.
+func (c *recordingSubResourceClient) Create(ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	c.parent.record(c.create, c.subResource, obj, client.ObjectKeyFromObject(obj))
+	return c.wrapped.Create(ctx, obj, subResource, opts...)
+}
+
+// Update records the call, then updates the sub-resource.
+func (c *recordingSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	c.parent.record(c.update, c.subResource, obj, client.ObjectKeyFromObject(obj))
+	return c.wrapped.Update(ctx, obj, opts...)
+}
+
+// Patch records the call, then patches the sub-resource.
+func (c *recordingSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	c.parent.record(c.patch, c.subResource, obj, client.ObjectKeyFromObject(obj))
+	return c.wrapped.Patch(ctx, obj, patch, opts...)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2024 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRecordingClient(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	key := types.NamespacedName{Namespace: "cool-namespace", Name: "cool-secret"}
+	gvk := corev1.SchemeGroupVersion.WithKind("Secret")
+
+	type args struct {
+		c  client.Client
+		o  []RecordingClientOption
+		fn func(ctx context.Context, c client.Client) error
+	}
+
+	type want struct {
+		calls []Call
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"GetThenStatusUpdate": {
+			reason: "A Get followed by a status update should be recorded in order, with the GVK and key of the object.",
+			args: args{
+				c: &MockClient{
+					MockGet: NewMockGetFn(nil, func(obj client.Object) error {
+						obj.SetNamespace(key.Namespace)
+						obj.SetName(key.Name)
+						return nil
+					}),
+					MockStatusUpdate: NewMockSubResourceUpdateFn(nil),
+				},
+				o: []RecordingClientOption{WithRecordingScheme(s)},
+				fn: func(ctx context.Context, c client.Client) error {
+					sec := &corev1.Secret{}
+					if err := c.Get(ctx, key, sec); err != nil {
+						return err
+					}
+					return c.Status().Update(ctx, sec)
+				},
+			},
+			want: want{
+				calls: []Call{
+					{Method: MethodGet, GVK: gvk, Key: key},
+					{Method: MethodStatusUpdate, GVK: gvk, Key: key},
+				},
+			},
+		},
+		"NoScheme": {
+			reason: "Without a scheme the GVK should be taken from the object, if set.",
+			args: args{
+				c: &MockClient{
+					MockGet: NewMockGetFn(nil),
+				},
+				fn: func(ctx context.Context, c client.Client) error {
+					if err := c.Get(ctx, key, &corev1.Secret{}); err != nil {
+						return err
+					}
+					sec := &corev1.Secret{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}}
+					return c.Get(ctx, key, sec)
+				},
+			},
+			want: want{
+				calls: []Call{
+					{Method: MethodGet, Key: key},
+					{Method: MethodGet, GVK: gvk, Key: key},
+				},
+			},
+		},
+		"SubResource": {
+			reason: "Calls to a named sub-resource should record the sub-resource name.",
+			args: args{
+				c: &MockClient{
+					MockSubResourcePatch: NewMockSubResourcePatchFn(nil),
+				},
+				o: []RecordingClientOption{WithRecordingScheme(s)},
+				fn: func(ctx context.Context, c client.Client) error {
+					sec := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+					return c.SubResource("scale").Patch(ctx, sec, client.MergeFrom(sec))
+				},
+			},
+			want: want{
+				calls: []Call{
+					{Method: MethodSubResourcePatch, SubResource: "scale", GVK: gvk, Key: key},
+				},
+			},
+		},
+		"List": {
+			reason: "List calls should record the list GVK and namespace.",
+			args: args{
+				c: &MockClient{
+					MockList: NewMockListFn(nil),
+				},
+				o: []RecordingClientOption{WithRecordingScheme(s)},
+				fn: func(ctx context.Context, c client.Client) error {
+					return c.List(ctx, &corev1.SecretList{}, client.InNamespace(key.Namespace))
+				},
+			},
+			want: want{
+				calls: []Call{
+					{Method: MethodList, GVK: corev1.SchemeGroupVersion.WithKind("SecretList"), Key: types.NamespacedName{Namespace: key.Namespace}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rc := NewRecordingClient(tc.args.c, tc.args.o...)
+			if err := tc.args.fn(context.Background(), rc); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.calls, rc.Calls()); diff != "" {
+				t.Errorf("\n%s\nCalls(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}